@@ -2,22 +2,191 @@ package main
 
 import (
 	"encoding/json"
+	"errors"
+	"flag"
 	"fmt"
+	"github.com/martin-sucha/cqldoc/render"
 	"github.com/martin-sucha/cqldoc/schema"
+	"io"
 	"os"
+	"path/filepath"
 )
 
+var commentPolicies = map[string]schema.CommentPolicy{
+	"leading": schema.CommentPreferLeading,
+	"option":  schema.CommentPreferOption,
+	"concat":  schema.CommentConcatenate,
+}
+
+// inputFiles returns the files to parse in the order they are applied.
+// Directories are replaced by the .cql files they contain, as returned by schema.CQLFileNames.
+func inputFiles(args []string) ([]string, error) {
+	var files []string
+	for _, arg := range args {
+		info, err := os.Stat(arg)
+		if err != nil {
+			return nil, err
+		}
+		if !info.IsDir() {
+			files = append(files, arg)
+			continue
+		}
+		names, err := schema.CQLFileNames(os.DirFS(arg))
+		if err != nil {
+			return nil, err
+		}
+		for _, name := range names {
+			files = append(files, filepath.Join(arg, name))
+		}
+	}
+	return files, nil
+}
+
+// outputOptions are the flags that control the output.
+type outputOptions struct {
+	// output is the output file or directory.
+	output string
+	// sourceURL is the template of links to the source of schema elements.
+	sourceURL string
+}
+
+// formats are the output formats selected by the first argument.
+var formats = map[string]func(s *schema.Schema, opts outputOptions) error{
+	"json":     writerFormat(writeJSON),
+	"markdown": writerFormat(render.Markdown),
+	"html":     writeHTML,
+}
+
+// writeJSON writes the schema as JSON.
+func writeJSON(w io.Writer, s *schema.Schema) error {
+	x, err := json.MarshalIndent(s, "", "    ")
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "%s\n", x)
+	return err
+}
+
+// writerFormat returns a format that writes to the output file, or to standard output if the file is not set.
+func writerFormat(write func(w io.Writer, s *schema.Schema) error) func(s *schema.Schema, opts outputOptions) error {
+	return func(s *schema.Schema, opts outputOptions) error {
+		if opts.output == "" {
+			return write(os.Stdout, s)
+		}
+		f, err := os.Create(opts.output)
+		if err != nil {
+			return err
+		}
+		err = write(f, s)
+		if closeErr := f.Close(); err == nil {
+			err = closeErr
+		}
+		return err
+	}
+}
+
+// templateFormat returns a format that executes the template in the file.
+func templateFormat(file string) (func(s *schema.Schema, opts outputOptions) error, error) {
+	t, err := render.NewTemplate(filepath.Base(file)).ParseFiles(file)
+	if err != nil {
+		return nil, err
+	}
+	return writerFormat(func(w io.Writer, s *schema.Schema) error {
+		return t.Execute(w, s)
+	}), nil
+}
+
+// writeHTML writes the schema as a static site to the output directory.
+func writeHTML(s *schema.Schema, opts outputOptions) error {
+	if opts.output == "" {
+		return errors.New("the html format requires an output directory set by -o")
+	}
+	return render.HTML(opts.output, s, render.HTMLOptions{SourceURL: opts.sourceURL})
+}
+
 func main() {
-	ret, err := schema.Parse(os.Stdin)
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [json|markdown|html] [flags] [file or directory ...]\n", os.Args[0])
+		fmt.Fprintf(flag.CommandLine.Output(), "Writes the schema as JSON unless another format or a template is given.\n")
+		fmt.Fprintf(flag.CommandLine.Output(), "Reads the schema from standard input if no files are given.\n")
+		flag.PrintDefaults()
+	}
+	commentPolicy := flag.String("comment-policy", "leading",
+		"how to combine leading comments with the comment option: leading, option or concat")
+	var opts outputOptions
+	flag.StringVar(&opts.output, "o", "", "output file, or output directory of the html format; standard output by default")
+	flag.StringVar(&opts.sourceURL, "source-url", "",
+		"template of links to the source in the html format, {file} and {line} are replaced by the position")
+	templateFile := flag.String("template", "", "text/template file to render the schema with instead of a format")
+
+	formatName := ""
+	args := os.Args[1:]
+	if len(args) > 0 {
+		if _, ok := formats[args[0]]; ok {
+			formatName = args[0]
+			args = args[1:]
+		}
+	}
+	flag.CommandLine.Parse(args)
+
+	format := formats["json"]
+	switch {
+	case *templateFile != "" && formatName != "":
+		fmt.Fprintf(os.Stderr, "error: -template can't be combined with the %s format\n", formatName)
+		os.Exit(2)
+		return
+	case *templateFile != "":
+		var err error
+		format, err = templateFormat(*templateFile)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "error: %s\n", err.Error())
+			os.Exit(1)
+			return
+		}
+	case formatName != "":
+		format = formats[formatName]
+	}
+
+	policy, ok := commentPolicies[*commentPolicy]
+	if !ok {
+		fmt.Fprintf(os.Stderr, "error: unknown comment policy %q\n", *commentPolicy)
+		os.Exit(2)
+		return
+	}
+
+	files, err := inputFiles(flag.Args())
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "error: %s\n", err.Error())
 		os.Exit(1)
 		return
 	}
-	x, err := json.MarshalIndent(ret, "", "    ")
+
+	var ret *schema.Schema
+	if len(files) == 0 {
+		ret, err = schema.Parse(os.Stdin, schema.WithCommentPolicy(policy), schema.WithDocComments())
+	} else {
+		ret, err = schema.ParseFiles(files, schema.WithCommentPolicy(policy), schema.WithDocComments())
+	}
 	if err != nil {
-		panic(err)
+		if parseErr, ok := err.(*schema.ParseError); ok {
+			for _, e := range parseErr.Errors() {
+				fmt.Fprintf(os.Stderr, "error: %s\n", e.Error())
+			}
+		} else {
+			fmt.Fprintf(os.Stderr, "error: %s\n", err.Error())
+		}
+		os.Exit(1)
+		return
+	}
+
+	for _, warning := range ret.ResolveLinks() {
+		fmt.Fprintf(os.Stderr, "warning: %s\n", warning)
 	}
-	fmt.Printf("%s\n", x)
 
+	err = format(ret, opts)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "error: %s\n", err.Error())
+		os.Exit(1)
+		return
+	}
 }
@@ -1,6 +1,6 @@
 module github.com/martin-sucha/cqldoc
 
-go 1.12
+go 1.16
 
 require (
 	github.com/antlr/antlr4 v0.0.0-20181218183524-be58ebffde8e
@@ -146,7 +146,7 @@ createTrigger
    ;
 
 createMaterializedView
-   : kwCreate kwMaterialized kwView ifNotExist? (keyspace DOT)? materializedView kwAs kwSelect columnList kwFrom (keyspace DOT)? table materializedViewWhere kwPrimary kwKey syntaxBracketLr columnList syntaxBracketRr (kwWith materializedViewOptions)?
+   : kwCreate kwMaterialized kwView ifNotExist? (keyspace DOT)? materializedView kwAs kwSelect (STAR | columnList) kwFrom (keyspace DOT)? table materializedViewWhere kwPrimary kwKey syntaxBracketLr primaryKeyDefinition syntaxBracketRr (kwWith materializedViewOptions)?
    ;
 
 materializedViewWhere
@@ -162,17 +162,16 @@ columnNotNull
    ;
 
 materializedViewOptions
-   : tableOptions
-   | tableOptions kwAnd clusteringOrder
-   | clusteringOrder
+   : tableOptions (kwAnd clusteringOrder (kwAnd tableOptions)?)?
+   | clusteringOrder (kwAnd tableOptions)?
    ;
 
 // CREATE MATERIALIZED VIEW [IF NOT EXISTS] [keyspace_name.] view_name
-// AS SELECT column_list
+// AS SELECT { * | column_list }
 // FROM [keyspace_name.] base_table_name
 // WHERE column_name IS NOT NULL [AND column_name IS NOT NULL ...] 
 //       [AND relation...] 
-// PRIMARY KEY ( column_list )
+// PRIMARY KEY ( primary_key )
 // [WITH [table_properties]
 //       [AND CLUSTERING ORDER BY (cluster_column_name order_option )]]
 createKeyspace
@@ -312,7 +311,7 @@ alterTableColumnsDefinition
    ;
 
 alterTableColumnDefinition
-   : column dataType
+   : column dataType kwStatic?
    ;
 
 alterRole
@@ -379,11 +378,11 @@ createTable
    ;
 
 withElement
-   : kwWith tableOptions? clusteringOrder?
+   : kwWith (tableOptions (kwAnd clusteringOrder (kwAnd tableOptions)?)? | clusteringOrder (kwAnd tableOptions)?)
    ;
 
 clusteringOrder
-   : kwClustering kwOrder kwBy syntaxBracketLr column orderDirection? syntaxBracketRr
+   : kwClustering kwOrder kwBy syntaxBracketLr column orderDirection? (syntaxComma column orderDirection?)* syntaxBracketRr
    ;
 
 tableOptions
@@ -427,7 +426,7 @@ columnDefinitionList
 
 //  
 columnDefinition
-   : column dataType primaryKeyColumn?
+   : column dataType kwStatic? primaryKeyColumn?
    ;
 
 // 
@@ -454,7 +453,7 @@ compoundKey
    ;
 
 compositeKey
-   : syntaxBracketLr partitionKeyList syntaxBracketRr (syntaxComma clusteringKeyList)
+   : syntaxBracketLr partitionKeyList syntaxBracketRr (syntaxComma clusteringKeyList)?
    ;
 
 partitionKeyList
@@ -768,7 +767,7 @@ column
    ;
 
 dataType
-   : dataTypeName dataTypeDefinition?
+   : (keyspace DOT)? dataTypeName dataTypeDefinition?
    ;
 
 dataTypeName
@@ -802,7 +801,7 @@ dataTypeName
    ;
 
 dataTypeDefinition
-   : syntaxBracketLa dataTypeName (syntaxComma dataTypeName)* syntaxBracketRa
+   : syntaxBracketLa dataType (syntaxComma dataType)* syntaxBracketRa
    ;
 
 orderDirection
@@ -1291,3 +1290,7 @@ syntaxComma
 syntaxColon
    : COLON
    ;
+
+kwStatic
+   : K_STATIC
+   ;
@@ -16,7 +16,7 @@ var _ = reflect.Copy
 var _ = strconv.Itoa
 
 var parserATN = []uint16{
-	3, 24715, 42794, 33075, 47597, 16764, 15335, 30598, 22884, 3, 178, 2239,
+	3, 24715, 42794, 33075, 47597, 16764, 15335, 30598, 22884, 3, 178, 2287,
 	4, 2, 9, 2, 4, 3, 9, 3, 4, 4, 9, 4, 4, 5, 9, 5, 4, 6, 9, 6, 4, 7, 9, 7,
 	4, 8, 9, 8, 4, 9, 9, 9, 4, 10, 9, 10, 4, 11, 9, 11, 4, 12, 9, 12, 4, 13,
 	9, 13, 4, 14, 9, 14, 4, 15, 9, 15, 4, 16, 9, 16, 4, 17, 9, 17, 4, 18, 9,
@@ -266,7 +266,13 @@ var parserATN = []uint16{
 	266, 3, 267, 3, 267, 3, 268, 3, 268, 3, 269, 3, 269, 3, 270, 3, 270, 3,
 	271, 3, 271, 3, 272, 3, 272, 3, 273, 3, 273, 3, 274, 3, 274, 3, 275, 3,
 	275, 3, 276, 3, 276, 3, 277, 3, 277, 3, 278, 3, 278, 3, 279, 3, 279, 3,
-	279, 2, 2, 280, 2, 4, 6, 8, 10, 12, 14, 16, 18, 20, 22, 24, 26, 28, 30,
+	279, 10, 88, 5, 88, 2239, 10, 72, 7, 72, 2241, 11, 72, 14, 72, 2243, 12,
+	72, 3, 72, 3, 72, 10, 72, 5, 72, 2248, 3, 72, 10, 19, 5, 19, 2251, 3, 19,
+	4, 280, 9, 280, 3, 280, 3, 280, 3, 82, 10, 82, 5, 82, 2259, 3, 55, 10,
+	55, 5, 55, 2262, 3, 71, 3, 71, 10, 71, 5, 71, 2266, 3, 71, 3, 71, 10, 71,
+	5, 71, 2270, 3, 71, 3, 71, 5, 157, 2275, 10, 157, 3, 157, 3, 157, 3, 157,
+	5, 23, 2280, 10, 23, 5, 23, 2282, 10, 23, 5, 23, 2284, 10, 23, 3, 23, 3,
+	23, 2, 2, 281, 2, 4, 6, 8, 10, 12, 14, 16, 18, 20, 22, 24, 26, 28, 30,
 	32, 34, 36, 38, 40, 42, 44, 46, 48, 50, 52, 54, 56, 58, 60, 62, 64, 66,
 	68, 70, 72, 74, 76, 78, 80, 82, 84, 86, 88, 90, 92, 94, 96, 98, 100, 102,
 	104, 106, 108, 110, 112, 114, 116, 118, 120, 122, 124, 126, 128, 130, 132,
@@ -284,219 +290,219 @@ var parserATN = []uint16{
 	464, 466, 468, 470, 472, 474, 476, 478, 480, 482, 484, 486, 488, 490, 492,
 	494, 496, 498, 500, 502, 504, 506, 508, 510, 512, 514, 516, 518, 520, 522,
 	524, 526, 528, 530, 532, 534, 536, 538, 540, 542, 544, 546, 548, 550, 552,
-	554, 556, 2, 7, 4, 2, 21, 21, 23, 23, 3, 2, 173, 177, 3, 2, 167, 168, 4,
-	2, 58, 58, 127, 127, 7, 2, 115, 115, 123, 123, 137, 137, 143, 164, 171,
-	171, 2, 2218, 2, 559, 3, 2, 2, 2, 4, 575, 3, 2, 2, 2, 6, 588, 3, 2, 2,
-	2, 8, 590, 3, 2, 2, 2, 10, 629, 3, 2, 2, 2, 12, 631, 3, 2, 2, 2, 14, 638,
-	3, 2, 2, 2, 16, 641, 3, 2, 2, 2, 18, 651, 3, 2, 2, 2, 20, 663, 3, 2, 2,
-	2, 22, 682, 3, 2, 2, 2, 24, 722, 3, 2, 2, 2, 26, 724, 3, 2, 2, 2, 28, 737,
-	3, 2, 2, 2, 30, 746, 3, 2, 2, 2, 32, 761, 3, 2, 2, 2, 34, 772, 3, 2, 2,
-	2, 36, 786, 3, 2, 2, 2, 38, 819, 3, 2, 2, 2, 40, 826, 3, 2, 2, 2, 42, 835,
-	3, 2, 2, 2, 44, 846, 3, 2, 2, 2, 46, 848, 3, 2, 2, 2, 48, 865, 3, 2, 2,
-	2, 50, 892, 3, 2, 2, 2, 52, 894, 3, 2, 2, 2, 54, 907, 3, 2, 2, 2, 56, 913,
-	3, 2, 2, 2, 58, 943, 3, 2, 2, 2, 60, 945, 3, 2, 2, 2, 62, 957, 3, 2, 2,
-	2, 64, 961, 3, 2, 2, 2, 66, 974, 3, 2, 2, 2, 68, 986, 3, 2, 2, 2, 70, 989,
-	3, 2, 2, 2, 72, 997, 3, 2, 2, 2, 74, 1002, 3, 2, 2, 2, 76, 1004, 3, 2,
-	2, 2, 78, 1017, 3, 2, 2, 2, 80, 1019, 3, 2, 2, 2, 82, 1022, 3, 2, 2, 2,
-	84, 1031, 3, 2, 2, 2, 86, 1035, 3, 2, 2, 2, 88, 1047, 3, 2, 2, 2, 90, 1052,
-	3, 2, 2, 2, 92, 1067, 3, 2, 2, 2, 94, 1069, 3, 2, 2, 2, 96, 1072, 3, 2,
-	2, 2, 98, 1077, 3, 2, 2, 2, 100, 1081, 3, 2, 2, 2, 102, 1084, 3, 2, 2,
-	2, 104, 1093, 3, 2, 2, 2, 106, 1096, 3, 2, 2, 2, 108, 1105, 3, 2, 2, 2,
-	110, 1108, 3, 2, 2, 2, 112, 1114, 3, 2, 2, 2, 114, 1140, 3, 2, 2, 2, 116,
-	1142, 3, 2, 2, 2, 118, 1156, 3, 2, 2, 2, 120, 1163, 3, 2, 2, 2, 122, 1175,
-	3, 2, 2, 2, 124, 1188, 3, 2, 2, 2, 126, 1200, 3, 2, 2, 2, 128, 1212, 3,
-	2, 2, 2, 130, 1226, 3, 2, 2, 2, 132, 1233, 3, 2, 2, 2, 134, 1245, 3, 2,
-	2, 2, 136, 1252, 3, 2, 2, 2, 138, 1264, 3, 2, 2, 2, 140, 1281, 3, 2, 2,
-	2, 142, 1288, 3, 2, 2, 2, 144, 1298, 3, 2, 2, 2, 146, 1315, 3, 2, 2, 2,
-	148, 1317, 3, 2, 2, 2, 150, 1321, 3, 2, 2, 2, 152, 1323, 3, 2, 2, 2, 154,
-	1335, 3, 2, 2, 2, 156, 1339, 3, 2, 2, 2, 158, 1343, 3, 2, 2, 2, 160, 1345,
-	3, 2, 2, 2, 162, 1359, 3, 2, 2, 2, 164, 1364, 3, 2, 2, 2, 166, 1367, 3,
-	2, 2, 2, 168, 1376, 3, 2, 2, 2, 170, 1378, 3, 2, 2, 2, 172, 1380, 3, 2,
-	2, 2, 174, 1384, 3, 2, 2, 2, 176, 1390, 3, 2, 2, 2, 178, 1399, 3, 2, 2,
-	2, 180, 1408, 3, 2, 2, 2, 182, 1410, 3, 2, 2, 2, 184, 1412, 3, 2, 2, 2,
-	186, 1415, 3, 2, 2, 2, 188, 1425, 3, 2, 2, 2, 190, 1427, 3, 2, 2, 2, 192,
-	1441, 3, 2, 2, 2, 194, 1456, 3, 2, 2, 2, 196, 1458, 3, 2, 2, 2, 198, 1462,
-	3, 2, 2, 2, 200, 1465, 3, 2, 2, 2, 202, 1476, 3, 2, 2, 2, 204, 1497, 3,
-	2, 2, 2, 206, 1503, 3, 2, 2, 2, 208, 1505, 3, 2, 2, 2, 210, 1510, 3, 2,
-	2, 2, 212, 1515, 3, 2, 2, 2, 214, 1521, 3, 2, 2, 2, 216, 1536, 3, 2, 2,
-	2, 218, 1554, 3, 2, 2, 2, 220, 1557, 3, 2, 2, 2, 222, 1576, 3, 2, 2, 2,
-	224, 1579, 3, 2, 2, 2, 226, 1588, 3, 2, 2, 2, 228, 1592, 3, 2, 2, 2, 230,
-	1654, 3, 2, 2, 2, 232, 1656, 3, 2, 2, 2, 234, 1668, 3, 2, 2, 2, 236, 1684,
-	3, 2, 2, 2, 238, 1698, 3, 2, 2, 2, 240, 1734, 3, 2, 2, 2, 242, 1736, 3,
-	2, 2, 2, 244, 1739, 3, 2, 2, 2, 246, 1742, 3, 2, 2, 2, 248, 1745, 3, 2,
-	2, 2, 250, 1749, 3, 2, 2, 2, 252, 1752, 3, 2, 2, 2, 254, 1757, 3, 2, 2,
-	2, 256, 1761, 3, 2, 2, 2, 258, 1774, 3, 2, 2, 2, 260, 1788, 3, 2, 2, 2,
-	262, 1807, 3, 2, 2, 2, 264, 1810, 3, 2, 2, 2, 266, 1813, 3, 2, 2, 2, 268,
-	1820, 3, 2, 2, 2, 270, 1822, 3, 2, 2, 2, 272, 1826, 3, 2, 2, 2, 274, 1831,
-	3, 2, 2, 2, 276, 1834, 3, 2, 2, 2, 278, 1838, 3, 2, 2, 2, 280, 1863, 3,
-	2, 2, 2, 282, 1865, 3, 2, 2, 2, 284, 1900, 3, 2, 2, 2, 286, 1902, 3, 2,
-	2, 2, 288, 1906, 3, 2, 2, 2, 290, 1922, 3, 2, 2, 2, 292, 1927, 3, 2, 2,
-	2, 294, 1946, 3, 2, 2, 2, 296, 1948, 3, 2, 2, 2, 298, 1950, 3, 2, 2, 2,
-	300, 1952, 3, 2, 2, 2, 302, 1954, 3, 2, 2, 2, 304, 1956, 3, 2, 2, 2, 306,
-	1962, 3, 2, 2, 2, 308, 1968, 3, 2, 2, 2, 310, 1974, 3, 2, 2, 2, 312, 1976,
-	3, 2, 2, 2, 314, 1980, 3, 2, 2, 2, 316, 1982, 3, 2, 2, 2, 318, 1996, 3,
-	2, 2, 2, 320, 1998, 3, 2, 2, 2, 322, 2000, 3, 2, 2, 2, 324, 2002, 3, 2,
-	2, 2, 326, 2004, 3, 2, 2, 2, 328, 2006, 3, 2, 2, 2, 330, 2008, 3, 2, 2,
-	2, 332, 2010, 3, 2, 2, 2, 334, 2012, 3, 2, 2, 2, 336, 2014, 3, 2, 2, 2,
-	338, 2016, 3, 2, 2, 2, 340, 2018, 3, 2, 2, 2, 342, 2020, 3, 2, 2, 2, 344,
-	2023, 3, 2, 2, 2, 346, 2025, 3, 2, 2, 2, 348, 2027, 3, 2, 2, 2, 350, 2029,
-	3, 2, 2, 2, 352, 2031, 3, 2, 2, 2, 354, 2034, 3, 2, 2, 2, 356, 2036, 3,
-	2, 2, 2, 358, 2038, 3, 2, 2, 2, 360, 2040, 3, 2, 2, 2, 362, 2042, 3, 2,
-	2, 2, 364, 2044, 3, 2, 2, 2, 366, 2046, 3, 2, 2, 2, 368, 2048, 3, 2, 2,
-	2, 370, 2050, 3, 2, 2, 2, 372, 2052, 3, 2, 2, 2, 374, 2054, 3, 2, 2, 2,
-	376, 2056, 3, 2, 2, 2, 378, 2058, 3, 2, 2, 2, 380, 2060, 3, 2, 2, 2, 382,
-	2062, 3, 2, 2, 2, 384, 2064, 3, 2, 2, 2, 386, 2066, 3, 2, 2, 2, 388, 2068,
-	3, 2, 2, 2, 390, 2070, 3, 2, 2, 2, 392, 2072, 3, 2, 2, 2, 394, 2074, 3,
-	2, 2, 2, 396, 2076, 3, 2, 2, 2, 398, 2078, 3, 2, 2, 2, 400, 2080, 3, 2,
-	2, 2, 402, 2082, 3, 2, 2, 2, 404, 2084, 3, 2, 2, 2, 406, 2086, 3, 2, 2,
-	2, 408, 2088, 3, 2, 2, 2, 410, 2090, 3, 2, 2, 2, 412, 2092, 3, 2, 2, 2,
-	414, 2094, 3, 2, 2, 2, 416, 2096, 3, 2, 2, 2, 418, 2098, 3, 2, 2, 2, 420,
-	2100, 3, 2, 2, 2, 422, 2102, 3, 2, 2, 2, 424, 2104, 3, 2, 2, 2, 426, 2106,
-	3, 2, 2, 2, 428, 2108, 3, 2, 2, 2, 430, 2110, 3, 2, 2, 2, 432, 2112, 3,
-	2, 2, 2, 434, 2114, 3, 2, 2, 2, 436, 2116, 3, 2, 2, 2, 438, 2118, 3, 2,
-	2, 2, 440, 2120, 3, 2, 2, 2, 442, 2122, 3, 2, 2, 2, 444, 2124, 3, 2, 2,
-	2, 446, 2126, 3, 2, 2, 2, 448, 2128, 3, 2, 2, 2, 450, 2130, 3, 2, 2, 2,
-	452, 2132, 3, 2, 2, 2, 454, 2134, 3, 2, 2, 2, 456, 2136, 3, 2, 2, 2, 458,
-	2138, 3, 2, 2, 2, 460, 2140, 3, 2, 2, 2, 462, 2142, 3, 2, 2, 2, 464, 2144,
-	3, 2, 2, 2, 466, 2146, 3, 2, 2, 2, 468, 2148, 3, 2, 2, 2, 470, 2150, 3,
-	2, 2, 2, 472, 2152, 3, 2, 2, 2, 474, 2154, 3, 2, 2, 2, 476, 2156, 3, 2,
-	2, 2, 478, 2158, 3, 2, 2, 2, 480, 2160, 3, 2, 2, 2, 482, 2162, 3, 2, 2,
-	2, 484, 2164, 3, 2, 2, 2, 486, 2166, 3, 2, 2, 2, 488, 2168, 3, 2, 2, 2,
-	490, 2170, 3, 2, 2, 2, 492, 2172, 3, 2, 2, 2, 494, 2174, 3, 2, 2, 2, 496,
-	2176, 3, 2, 2, 2, 498, 2178, 3, 2, 2, 2, 500, 2180, 3, 2, 2, 2, 502, 2182,
-	3, 2, 2, 2, 504, 2184, 3, 2, 2, 2, 506, 2186, 3, 2, 2, 2, 508, 2188, 3,
-	2, 2, 2, 510, 2190, 3, 2, 2, 2, 512, 2192, 3, 2, 2, 2, 514, 2194, 3, 2,
-	2, 2, 516, 2196, 3, 2, 2, 2, 518, 2198, 3, 2, 2, 2, 520, 2200, 3, 2, 2,
-	2, 522, 2202, 3, 2, 2, 2, 524, 2204, 3, 2, 2, 2, 526, 2206, 3, 2, 2, 2,
-	528, 2208, 3, 2, 2, 2, 530, 2210, 3, 2, 2, 2, 532, 2212, 3, 2, 2, 2, 534,
-	2214, 3, 2, 2, 2, 536, 2216, 3, 2, 2, 2, 538, 2218, 3, 2, 2, 2, 540, 2220,
-	3, 2, 2, 2, 542, 2222, 3, 2, 2, 2, 544, 2224, 3, 2, 2, 2, 546, 2226, 3,
-	2, 2, 2, 548, 2228, 3, 2, 2, 2, 550, 2230, 3, 2, 2, 2, 552, 2232, 3, 2,
-	2, 2, 554, 2234, 3, 2, 2, 2, 556, 2236, 3, 2, 2, 2, 558, 560, 5, 4, 3,
-	2, 559, 558, 3, 2, 2, 2, 559, 560, 3, 2, 2, 2, 560, 562, 3, 2, 2, 2, 561,
-	563, 7, 22, 2, 2, 562, 561, 3, 2, 2, 2, 562, 563, 3, 2, 2, 2, 563, 564,
-	3, 2, 2, 2, 564, 565, 5, 536, 269, 2, 565, 3, 3, 2, 2, 2, 566, 568, 5,
-	10, 6, 2, 567, 569, 7, 22, 2, 2, 568, 567, 3, 2, 2, 2, 568, 569, 3, 2,
-	2, 2, 569, 570, 3, 2, 2, 2, 570, 571, 5, 6, 4, 2, 571, 574, 3, 2, 2, 2,
-	572, 574, 5, 8, 5, 2, 573, 566, 3, 2, 2, 2, 573, 572, 3, 2, 2, 2, 574,
-	577, 3, 2, 2, 2, 575, 573, 3, 2, 2, 2, 575, 576, 3, 2, 2, 2, 576, 586,
-	3, 2, 2, 2, 577, 575, 3, 2, 2, 2, 578, 583, 5, 10, 6, 2, 579, 581, 7, 22,
-	2, 2, 580, 579, 3, 2, 2, 2, 580, 581, 3, 2, 2, 2, 581, 582, 3, 2, 2, 2,
-	582, 584, 5, 6, 4, 2, 583, 580, 3, 2, 2, 2, 583, 584, 3, 2, 2, 2, 584,
-	587, 3, 2, 2, 2, 585, 587, 5, 8, 5, 2, 586, 578, 3, 2, 2, 2, 586, 585,
-	3, 2, 2, 2, 587, 5, 3, 2, 2, 2, 588, 589, 7, 10, 2, 2, 589, 7, 3, 2, 2,
-	2, 590, 591, 5, 6, 4, 2, 591, 9, 3, 2, 2, 2, 592, 630, 5, 190, 96, 2, 593,
-	630, 5, 116, 59, 2, 594, 630, 5, 110, 56, 2, 595, 630, 5, 90, 46, 2, 596,
-	630, 5, 76, 39, 2, 597, 630, 5, 70, 36, 2, 598, 630, 5, 184, 93, 2, 599,
-	630, 5, 56, 29, 2, 600, 630, 5, 48, 25, 2, 601, 630, 5, 202, 102, 2, 602,
-	630, 5, 46, 24, 2, 603, 630, 5, 36, 19, 2, 604, 630, 5, 28, 15, 2, 605,
-	630, 5, 138, 70, 2, 606, 630, 5, 34, 18, 2, 607, 630, 5, 30, 16, 2, 608,
-	630, 5, 26, 14, 2, 609, 630, 5, 214, 108, 2, 610, 630, 5, 124, 63, 2, 611,
-	630, 5, 126, 64, 2, 612, 630, 5, 136, 69, 2, 613, 630, 5, 134, 68, 2, 614,
-	630, 5, 122, 62, 2, 615, 630, 5, 130, 66, 2, 616, 630, 5, 132, 67, 2, 617,
-	630, 5, 128, 65, 2, 618, 630, 5, 120, 61, 2, 619, 630, 5, 118, 60, 2, 620,
-	630, 5, 20, 11, 2, 621, 630, 5, 238, 120, 2, 622, 630, 5, 18, 10, 2, 623,
-	630, 5, 16, 9, 2, 624, 630, 5, 12, 7, 2, 625, 630, 5, 260, 131, 2, 626,
-	630, 5, 200, 101, 2, 627, 630, 5, 220, 111, 2, 628, 630, 5, 198, 100, 2,
-	629, 592, 3, 2, 2, 2, 629, 593, 3, 2, 2, 2, 629, 594, 3, 2, 2, 2, 629,
-	595, 3, 2, 2, 2, 629, 596, 3, 2, 2, 2, 629, 597, 3, 2, 2, 2, 629, 598,
-	3, 2, 2, 2, 629, 599, 3, 2, 2, 2, 629, 600, 3, 2, 2, 2, 629, 601, 3, 2,
-	2, 2, 629, 602, 3, 2, 2, 2, 629, 603, 3, 2, 2, 2, 629, 604, 3, 2, 2, 2,
-	629, 605, 3, 2, 2, 2, 629, 606, 3, 2, 2, 2, 629, 607, 3, 2, 2, 2, 629,
-	608, 3, 2, 2, 2, 629, 609, 3, 2, 2, 2, 629, 610, 3, 2, 2, 2, 629, 611,
-	3, 2, 2, 2, 629, 612, 3, 2, 2, 2, 629, 613, 3, 2, 2, 2, 629, 614, 3, 2,
-	2, 2, 629, 615, 3, 2, 2, 2, 629, 616, 3, 2, 2, 2, 629, 617, 3, 2, 2, 2,
-	629, 618, 3, 2, 2, 2, 629, 619, 3, 2, 2, 2, 629, 620, 3, 2, 2, 2, 629,
-	621, 3, 2, 2, 2, 629, 622, 3, 2, 2, 2, 629, 623, 3, 2, 2, 2, 629, 624,
-	3, 2, 2, 2, 629, 625, 3, 2, 2, 2, 629, 626, 3, 2, 2, 2, 629, 627, 3, 2,
-	2, 2, 629, 628, 3, 2, 2, 2, 630, 11, 3, 2, 2, 2, 631, 632, 5, 534, 268,
-	2, 632, 633, 5, 22, 12, 2, 633, 634, 5, 464, 233, 2, 634, 635, 5, 24, 13,
-	2, 635, 636, 5, 406, 204, 2, 636, 637, 5, 320, 161, 2, 637, 13, 3, 2, 2,
-	2, 638, 639, 5, 444, 223, 2, 639, 640, 5, 522, 262, 2, 640, 15, 3, 2, 2,
-	2, 641, 642, 5, 444, 223, 2, 642, 646, 5, 486, 244, 2, 643, 644, 5, 462,
-	232, 2, 644, 645, 5, 320, 161, 2, 645, 647, 3, 2, 2, 2, 646, 643, 3, 2,
-	2, 2, 646, 647, 3, 2, 2, 2, 647, 649, 3, 2, 2, 2, 648, 650, 5, 456, 229,
-	2, 649, 648, 3, 2, 2, 2, 649, 650, 3, 2, 2, 2, 650, 17, 3, 2, 2, 2, 651,
-	652, 5, 444, 223, 2, 652, 656, 5, 22, 12, 2, 653, 654, 5, 464, 233, 2,
-	654, 655, 5, 24, 13, 2, 655, 657, 3, 2, 2, 2, 656, 653, 3, 2, 2, 2, 656,
-	657, 3, 2, 2, 2, 657, 661, 3, 2, 2, 2, 658, 659, 5, 462, 232, 2, 659, 660,
-	5, 320, 161, 2, 660, 662, 3, 2, 2, 2, 661, 658, 3, 2, 2, 2, 661, 662, 3,
-	2, 2, 2, 662, 19, 3, 2, 2, 2, 663, 664, 5, 414, 208, 2, 664, 665, 5, 22,
-	12, 2, 665, 666, 5, 464, 233, 2, 666, 667, 5, 24, 13, 2, 667, 668, 5, 504,
-	253, 2, 668, 669, 5, 320, 161, 2, 669, 21, 3, 2, 2, 2, 670, 673, 5, 350,
-	176, 2, 671, 673, 5, 352, 177, 2, 672, 670, 3, 2, 2, 2, 672, 671, 3, 2,
-	2, 2, 673, 683, 3, 2, 2, 2, 674, 683, 5, 356, 179, 2, 675, 683, 5, 366,
-	184, 2, 676, 683, 5, 388, 195, 2, 677, 683, 5, 398, 200, 2, 678, 683, 5,
-	382, 192, 2, 679, 683, 5, 392, 197, 2, 680, 683, 5, 452, 227, 2, 681, 683,
-	5, 488, 245, 2, 682, 672, 3, 2, 2, 2, 682, 674, 3, 2, 2, 2, 682, 675, 3,
-	2, 2, 2, 682, 676, 3, 2, 2, 2, 682, 677, 3, 2, 2, 2, 682, 678, 3, 2, 2,
-	2, 682, 679, 3, 2, 2, 2, 682, 680, 3, 2, 2, 2, 682, 681, 3, 2, 2, 2, 683,
-	23, 3, 2, 2, 2, 684, 685, 5, 350, 176, 2, 685, 686, 5, 412, 207, 2, 686,
-	723, 3, 2, 2, 2, 687, 688, 5, 350, 176, 2, 688, 689, 5, 412, 207, 2, 689,
-	690, 5, 418, 210, 2, 690, 691, 5, 436, 219, 2, 691, 692, 5, 306, 154, 2,
-	692, 723, 3, 2, 2, 2, 693, 697, 5, 410, 206, 2, 694, 695, 5, 306, 154,
-	2, 695, 696, 7, 17, 2, 2, 696, 698, 3, 2, 2, 2, 697, 694, 3, 2, 2, 2, 697,
-	698, 3, 2, 2, 2, 698, 699, 3, 2, 2, 2, 699, 700, 5, 332, 167, 2, 700, 723,
-	3, 2, 2, 2, 701, 702, 5, 350, 176, 2, 702, 703, 5, 438, 220, 2, 703, 723,
-	3, 2, 2, 2, 704, 705, 5, 436, 219, 2, 705, 706, 5, 306, 154, 2, 706, 723,
-	3, 2, 2, 2, 707, 709, 5, 500, 251, 2, 708, 707, 3, 2, 2, 2, 708, 709, 3,
-	2, 2, 2, 709, 713, 3, 2, 2, 2, 710, 711, 5, 306, 154, 2, 711, 712, 7, 17,
-	2, 2, 712, 714, 3, 2, 2, 2, 713, 710, 3, 2, 2, 2, 713, 714, 3, 2, 2, 2,
-	714, 715, 3, 2, 2, 2, 715, 723, 5, 308, 155, 2, 716, 717, 5, 350, 176,
-	2, 717, 718, 5, 486, 244, 2, 718, 723, 3, 2, 2, 2, 719, 720, 5, 484, 243,
-	2, 720, 721, 5, 320, 161, 2, 721, 723, 3, 2, 2, 2, 722, 684, 3, 2, 2, 2,
-	722, 687, 3, 2, 2, 2, 722, 693, 3, 2, 2, 2, 722, 701, 3, 2, 2, 2, 722,
-	704, 3, 2, 2, 2, 722, 708, 3, 2, 2, 2, 722, 716, 3, 2, 2, 2, 722, 719,
-	3, 2, 2, 2, 723, 25, 3, 2, 2, 2, 724, 725, 5, 382, 192, 2, 725, 727, 5,
-	520, 261, 2, 726, 728, 5, 248, 125, 2, 727, 726, 3, 2, 2, 2, 727, 728,
-	3, 2, 2, 2, 728, 729, 3, 2, 2, 2, 729, 730, 5, 336, 169, 2, 730, 731, 5,
-	532, 267, 2, 731, 732, 5, 472, 237, 2, 732, 735, 5, 300, 151, 2, 733, 736,
-	5, 498, 250, 2, 734, 736, 5, 454, 228, 2, 735, 733, 3, 2, 2, 2, 735, 734,
-	3, 2, 2, 2, 735, 736, 3, 2, 2, 2, 736, 27, 3, 2, 2, 2, 737, 738, 5, 382,
-	192, 2, 738, 740, 5, 484, 243, 2, 739, 741, 5, 248, 125, 2, 740, 739, 3,
-	2, 2, 2, 740, 741, 3, 2, 2, 2, 741, 742, 3, 2, 2, 2, 742, 744, 5, 320,
-	161, 2, 743, 745, 5, 112, 57, 2, 744, 743, 3, 2, 2, 2, 744, 745, 3, 2,
-	2, 2, 745, 29, 3, 2, 2, 2, 746, 747, 5, 382, 192, 2, 747, 749, 5, 512,
-	257, 2, 748, 750, 5, 248, 125, 2, 749, 748, 3, 2, 2, 2, 749, 750, 3, 2,
-	2, 2, 750, 754, 3, 2, 2, 2, 751, 752, 5, 306, 154, 2, 752, 753, 7, 17,
-	2, 2, 753, 755, 3, 2, 2, 2, 754, 751, 3, 2, 2, 2, 754, 755, 3, 2, 2, 2,
-	755, 756, 3, 2, 2, 2, 756, 757, 5, 328, 165, 2, 757, 758, 5, 538, 270,
-	2, 758, 759, 5, 32, 17, 2, 759, 760, 5, 540, 271, 2, 760, 31, 3, 2, 2,
-	2, 761, 762, 5, 310, 156, 2, 762, 769, 5, 312, 157, 2, 763, 764, 5, 554,
-	278, 2, 764, 765, 5, 310, 156, 2, 765, 766, 5, 312, 157, 2, 766, 768, 3,
-	2, 2, 2, 767, 763, 3, 2, 2, 2, 768, 771, 3, 2, 2, 2, 769, 767, 3, 2, 2,
-	2, 769, 770, 3, 2, 2, 2, 770, 33, 3, 2, 2, 2, 771, 769, 3, 2, 2, 2, 772,
-	773, 5, 382, 192, 2, 773, 775, 5, 506, 254, 2, 774, 776, 5, 248, 125, 2,
-	775, 774, 3, 2, 2, 2, 775, 776, 3, 2, 2, 2, 776, 780, 3, 2, 2, 2, 777,
-	778, 5, 306, 154, 2, 778, 779, 7, 17, 2, 2, 779, 781, 3, 2, 2, 2, 780,
-	777, 3, 2, 2, 2, 780, 781, 3, 2, 2, 2, 781, 782, 3, 2, 2, 2, 782, 783,
-	5, 322, 162, 2, 783, 784, 5, 524, 263, 2, 784, 785, 5, 324, 163, 2, 785,
-	35, 3, 2, 2, 2, 786, 787, 5, 382, 192, 2, 787, 788, 5, 450, 226, 2, 788,
-	790, 5, 528, 265, 2, 789, 791, 5, 248, 125, 2, 790, 789, 3, 2, 2, 2, 790,
-	791, 3, 2, 2, 2, 791, 795, 3, 2, 2, 2, 792, 793, 5, 306, 154, 2, 793, 794,
-	7, 17, 2, 2, 794, 796, 3, 2, 2, 2, 795, 792, 3, 2, 2, 2, 795, 796, 3, 2,
-	2, 2, 796, 797, 3, 2, 2, 2, 797, 798, 5, 326, 164, 2, 798, 799, 5, 362,
-	182, 2, 799, 800, 5, 488, 245, 2, 800, 801, 5, 256, 129, 2, 801, 805, 5,
-	406, 204, 2, 802, 803, 5, 306, 154, 2, 803, 804, 7, 17, 2, 2, 804, 806,
-	3, 2, 2, 2, 805, 802, 3, 2, 2, 2, 805, 806, 3, 2, 2, 2, 806, 807, 3, 2,
-	2, 2, 807, 808, 5, 308, 155, 2, 808, 809, 5, 38, 20, 2, 809, 810, 5, 474,
-	238, 2, 810, 811, 5, 432, 217, 2, 811, 812, 5, 538, 270, 2, 812, 813, 5,
-	256, 129, 2, 813, 817, 5, 540, 271, 2, 814, 815, 5, 532, 267, 2, 815, 816,
-	5, 44, 23, 2, 816, 818, 3, 2, 2, 2, 817, 814, 3, 2, 2, 2, 817, 818, 3,
-	2, 2, 2, 818, 37, 3, 2, 2, 2, 819, 820, 5, 530, 266, 2, 820, 824, 5, 40,
-	21, 2, 821, 822, 5, 358, 180, 2, 822, 823, 5, 282, 142, 2, 823, 825, 3,
-	2, 2, 2, 824, 821, 3, 2, 2, 2, 824, 825, 3, 2, 2, 2, 825, 39, 3, 2, 2,
-	2, 826, 832, 5, 42, 22, 2, 827, 828, 5, 358, 180, 2, 828, 829, 5, 42, 22,
-	2, 829, 831, 3, 2, 2, 2, 830, 827, 3, 2, 2, 2, 831, 834, 3, 2, 2, 2, 832,
-	830, 3, 2, 2, 2, 832, 833, 3, 2, 2, 2, 833, 41, 3, 2, 2, 2, 834, 832, 3,
-	2, 2, 2, 835, 836, 5, 310, 156, 2, 836, 837, 5, 430, 216, 2, 837, 838,
-	5, 458, 230, 2, 838, 839, 5, 460, 231, 2, 839, 43, 3, 2, 2, 2, 840, 847,
-	5, 144, 73, 2, 841, 842, 5, 144, 73, 2, 842, 843, 5, 358, 180, 2, 843,
-	844, 5, 142, 72, 2, 844, 847, 3, 2, 2, 2, 845, 847, 5, 142, 72, 2, 846,
-	840, 3, 2, 2, 2, 846, 841, 3, 2, 2, 2, 846, 845, 3, 2, 2, 2, 847, 45, 3,
-	2, 2, 2, 848, 849, 5, 382, 192, 2, 849, 851, 5, 436, 219, 2, 850, 852,
-	5, 248, 125, 2, 851, 850, 3, 2, 2, 2, 851, 852, 3, 2, 2, 2, 852, 853, 3,
-	2, 2, 2, 853, 854, 5, 306, 154, 2, 854, 855, 5, 532, 267, 2, 855, 856,
+	554, 556, 2254, 2, 7, 4, 2, 21, 21, 23, 23, 3, 2, 173, 177, 3, 2, 167,
+	168, 4, 2, 58, 58, 127, 127, 7, 2, 115, 115, 123, 123, 137, 137, 143, 164,
+	171, 171, 2, 2276, 2, 559, 3, 2, 2, 2, 4, 575, 3, 2, 2, 2, 6, 588, 3, 2,
+	2, 2, 8, 590, 3, 2, 2, 2, 10, 629, 3, 2, 2, 2, 12, 631, 3, 2, 2, 2, 14,
+	638, 3, 2, 2, 2, 16, 641, 3, 2, 2, 2, 18, 651, 3, 2, 2, 2, 20, 663, 3,
+	2, 2, 2, 22, 682, 3, 2, 2, 2, 24, 722, 3, 2, 2, 2, 26, 724, 3, 2, 2, 2,
+	28, 737, 3, 2, 2, 2, 30, 746, 3, 2, 2, 2, 32, 761, 3, 2, 2, 2, 34, 772,
+	3, 2, 2, 2, 36, 786, 3, 2, 2, 2, 38, 819, 3, 2, 2, 2, 40, 826, 3, 2, 2,
+	2, 42, 835, 3, 2, 2, 2, 44, 846, 3, 2, 2, 2, 46, 848, 3, 2, 2, 2, 48, 865,
+	3, 2, 2, 2, 50, 892, 3, 2, 2, 2, 52, 894, 3, 2, 2, 2, 54, 907, 3, 2, 2,
+	2, 56, 913, 3, 2, 2, 2, 58, 943, 3, 2, 2, 2, 60, 945, 3, 2, 2, 2, 62, 957,
+	3, 2, 2, 2, 64, 961, 3, 2, 2, 2, 66, 974, 3, 2, 2, 2, 68, 986, 3, 2, 2,
+	2, 70, 989, 3, 2, 2, 2, 72, 997, 3, 2, 2, 2, 74, 1002, 3, 2, 2, 2, 76,
+	1004, 3, 2, 2, 2, 78, 1017, 3, 2, 2, 2, 80, 1019, 3, 2, 2, 2, 82, 1022,
+	3, 2, 2, 2, 84, 1031, 3, 2, 2, 2, 86, 1035, 3, 2, 2, 2, 88, 1047, 3, 2,
+	2, 2, 90, 1052, 3, 2, 2, 2, 92, 1067, 3, 2, 2, 2, 94, 1069, 3, 2, 2, 2,
+	96, 1072, 3, 2, 2, 2, 98, 1077, 3, 2, 2, 2, 100, 1081, 3, 2, 2, 2, 102,
+	1084, 3, 2, 2, 2, 104, 1093, 3, 2, 2, 2, 106, 1096, 3, 2, 2, 2, 108, 1105,
+	3, 2, 2, 2, 110, 1108, 3, 2, 2, 2, 112, 1114, 3, 2, 2, 2, 114, 1140, 3,
+	2, 2, 2, 116, 1142, 3, 2, 2, 2, 118, 1156, 3, 2, 2, 2, 120, 1163, 3, 2,
+	2, 2, 122, 1175, 3, 2, 2, 2, 124, 1188, 3, 2, 2, 2, 126, 1200, 3, 2, 2,
+	2, 128, 1212, 3, 2, 2, 2, 130, 1226, 3, 2, 2, 2, 132, 1233, 3, 2, 2, 2,
+	134, 1245, 3, 2, 2, 2, 136, 1252, 3, 2, 2, 2, 138, 1264, 3, 2, 2, 2, 140,
+	1281, 3, 2, 2, 2, 142, 1288, 3, 2, 2, 2, 144, 1298, 3, 2, 2, 2, 146, 1315,
+	3, 2, 2, 2, 148, 1317, 3, 2, 2, 2, 150, 1321, 3, 2, 2, 2, 152, 1323, 3,
+	2, 2, 2, 154, 1335, 3, 2, 2, 2, 156, 1339, 3, 2, 2, 2, 158, 1343, 3, 2,
+	2, 2, 160, 1345, 3, 2, 2, 2, 162, 1359, 3, 2, 2, 2, 164, 1364, 3, 2, 2,
+	2, 166, 1367, 3, 2, 2, 2, 168, 1376, 3, 2, 2, 2, 170, 1378, 3, 2, 2, 2,
+	172, 1380, 3, 2, 2, 2, 174, 1384, 3, 2, 2, 2, 176, 1390, 3, 2, 2, 2, 178,
+	1399, 3, 2, 2, 2, 180, 1408, 3, 2, 2, 2, 182, 1410, 3, 2, 2, 2, 184, 1412,
+	3, 2, 2, 2, 186, 1415, 3, 2, 2, 2, 188, 1425, 3, 2, 2, 2, 190, 1427, 3,
+	2, 2, 2, 192, 1441, 3, 2, 2, 2, 194, 1456, 3, 2, 2, 2, 196, 1458, 3, 2,
+	2, 2, 198, 1462, 3, 2, 2, 2, 200, 1465, 3, 2, 2, 2, 202, 1476, 3, 2, 2,
+	2, 204, 1497, 3, 2, 2, 2, 206, 1503, 3, 2, 2, 2, 208, 1505, 3, 2, 2, 2,
+	210, 1510, 3, 2, 2, 2, 212, 1515, 3, 2, 2, 2, 214, 1521, 3, 2, 2, 2, 216,
+	1536, 3, 2, 2, 2, 218, 1554, 3, 2, 2, 2, 220, 1557, 3, 2, 2, 2, 222, 1576,
+	3, 2, 2, 2, 224, 1579, 3, 2, 2, 2, 226, 1588, 3, 2, 2, 2, 228, 1592, 3,
+	2, 2, 2, 230, 1654, 3, 2, 2, 2, 232, 1656, 3, 2, 2, 2, 234, 1668, 3, 2,
+	2, 2, 236, 1684, 3, 2, 2, 2, 238, 1698, 3, 2, 2, 2, 240, 1734, 3, 2, 2,
+	2, 242, 1736, 3, 2, 2, 2, 244, 1739, 3, 2, 2, 2, 246, 1742, 3, 2, 2, 2,
+	248, 1745, 3, 2, 2, 2, 250, 1749, 3, 2, 2, 2, 252, 1752, 3, 2, 2, 2, 254,
+	1757, 3, 2, 2, 2, 256, 1761, 3, 2, 2, 2, 258, 1774, 3, 2, 2, 2, 260, 1788,
+	3, 2, 2, 2, 262, 1807, 3, 2, 2, 2, 264, 1810, 3, 2, 2, 2, 266, 1813, 3,
+	2, 2, 2, 268, 1820, 3, 2, 2, 2, 270, 1822, 3, 2, 2, 2, 272, 1826, 3, 2,
+	2, 2, 274, 1831, 3, 2, 2, 2, 276, 1834, 3, 2, 2, 2, 278, 1838, 3, 2, 2,
+	2, 280, 1863, 3, 2, 2, 2, 282, 1865, 3, 2, 2, 2, 284, 1900, 3, 2, 2, 2,
+	286, 1902, 3, 2, 2, 2, 288, 1906, 3, 2, 2, 2, 290, 1922, 3, 2, 2, 2, 292,
+	1927, 3, 2, 2, 2, 294, 1946, 3, 2, 2, 2, 296, 1948, 3, 2, 2, 2, 298, 1950,
+	3, 2, 2, 2, 300, 1952, 3, 2, 2, 2, 302, 1954, 3, 2, 2, 2, 304, 1956, 3,
+	2, 2, 2, 306, 1962, 3, 2, 2, 2, 308, 1968, 3, 2, 2, 2, 310, 1974, 3, 2,
+	2, 2, 312, 2274, 3, 2, 2, 2, 314, 1980, 3, 2, 2, 2, 316, 1982, 3, 2, 2,
+	2, 318, 1996, 3, 2, 2, 2, 320, 1998, 3, 2, 2, 2, 322, 2000, 3, 2, 2, 2,
+	324, 2002, 3, 2, 2, 2, 326, 2004, 3, 2, 2, 2, 328, 2006, 3, 2, 2, 2, 330,
+	2008, 3, 2, 2, 2, 332, 2010, 3, 2, 2, 2, 334, 2012, 3, 2, 2, 2, 336, 2014,
+	3, 2, 2, 2, 338, 2016, 3, 2, 2, 2, 340, 2018, 3, 2, 2, 2, 342, 2020, 3,
+	2, 2, 2, 344, 2023, 3, 2, 2, 2, 346, 2025, 3, 2, 2, 2, 348, 2027, 3, 2,
+	2, 2, 350, 2029, 3, 2, 2, 2, 352, 2031, 3, 2, 2, 2, 354, 2034, 3, 2, 2,
+	2, 356, 2036, 3, 2, 2, 2, 358, 2038, 3, 2, 2, 2, 360, 2040, 3, 2, 2, 2,
+	362, 2042, 3, 2, 2, 2, 364, 2044, 3, 2, 2, 2, 366, 2046, 3, 2, 2, 2, 368,
+	2048, 3, 2, 2, 2, 370, 2050, 3, 2, 2, 2, 372, 2052, 3, 2, 2, 2, 374, 2054,
+	3, 2, 2, 2, 376, 2056, 3, 2, 2, 2, 378, 2058, 3, 2, 2, 2, 380, 2060, 3,
+	2, 2, 2, 382, 2062, 3, 2, 2, 2, 384, 2064, 3, 2, 2, 2, 386, 2066, 3, 2,
+	2, 2, 388, 2068, 3, 2, 2, 2, 390, 2070, 3, 2, 2, 2, 392, 2072, 3, 2, 2,
+	2, 394, 2074, 3, 2, 2, 2, 396, 2076, 3, 2, 2, 2, 398, 2078, 3, 2, 2, 2,
+	400, 2080, 3, 2, 2, 2, 402, 2082, 3, 2, 2, 2, 404, 2084, 3, 2, 2, 2, 406,
+	2086, 3, 2, 2, 2, 408, 2088, 3, 2, 2, 2, 410, 2090, 3, 2, 2, 2, 412, 2092,
+	3, 2, 2, 2, 414, 2094, 3, 2, 2, 2, 416, 2096, 3, 2, 2, 2, 418, 2098, 3,
+	2, 2, 2, 420, 2100, 3, 2, 2, 2, 422, 2102, 3, 2, 2, 2, 424, 2104, 3, 2,
+	2, 2, 426, 2106, 3, 2, 2, 2, 428, 2108, 3, 2, 2, 2, 430, 2110, 3, 2, 2,
+	2, 432, 2112, 3, 2, 2, 2, 434, 2114, 3, 2, 2, 2, 436, 2116, 3, 2, 2, 2,
+	438, 2118, 3, 2, 2, 2, 440, 2120, 3, 2, 2, 2, 442, 2122, 3, 2, 2, 2, 444,
+	2124, 3, 2, 2, 2, 446, 2126, 3, 2, 2, 2, 448, 2128, 3, 2, 2, 2, 450, 2130,
+	3, 2, 2, 2, 452, 2132, 3, 2, 2, 2, 454, 2134, 3, 2, 2, 2, 456, 2136, 3,
+	2, 2, 2, 458, 2138, 3, 2, 2, 2, 460, 2140, 3, 2, 2, 2, 462, 2142, 3, 2,
+	2, 2, 464, 2144, 3, 2, 2, 2, 466, 2146, 3, 2, 2, 2, 468, 2148, 3, 2, 2,
+	2, 470, 2150, 3, 2, 2, 2, 472, 2152, 3, 2, 2, 2, 474, 2154, 3, 2, 2, 2,
+	476, 2156, 3, 2, 2, 2, 478, 2158, 3, 2, 2, 2, 480, 2160, 3, 2, 2, 2, 482,
+	2162, 3, 2, 2, 2, 484, 2164, 3, 2, 2, 2, 486, 2166, 3, 2, 2, 2, 488, 2168,
+	3, 2, 2, 2, 490, 2170, 3, 2, 2, 2, 492, 2172, 3, 2, 2, 2, 494, 2174, 3,
+	2, 2, 2, 496, 2176, 3, 2, 2, 2, 498, 2178, 3, 2, 2, 2, 500, 2180, 3, 2,
+	2, 2, 502, 2182, 3, 2, 2, 2, 504, 2184, 3, 2, 2, 2, 506, 2186, 3, 2, 2,
+	2, 508, 2188, 3, 2, 2, 2, 510, 2190, 3, 2, 2, 2, 512, 2192, 3, 2, 2, 2,
+	514, 2194, 3, 2, 2, 2, 516, 2196, 3, 2, 2, 2, 518, 2198, 3, 2, 2, 2, 520,
+	2200, 3, 2, 2, 2, 522, 2202, 3, 2, 2, 2, 524, 2204, 3, 2, 2, 2, 526, 2206,
+	3, 2, 2, 2, 528, 2208, 3, 2, 2, 2, 530, 2210, 3, 2, 2, 2, 532, 2212, 3,
+	2, 2, 2, 534, 2214, 3, 2, 2, 2, 536, 2216, 3, 2, 2, 2, 538, 2218, 3, 2,
+	2, 2, 540, 2220, 3, 2, 2, 2, 542, 2222, 3, 2, 2, 2, 544, 2224, 3, 2, 2,
+	2, 546, 2226, 3, 2, 2, 2, 548, 2228, 3, 2, 2, 2, 550, 2230, 3, 2, 2, 2,
+	552, 2232, 3, 2, 2, 2, 554, 2234, 3, 2, 2, 2, 556, 2236, 3, 2, 2, 2, 558,
+	560, 5, 4, 3, 2, 559, 558, 3, 2, 2, 2, 559, 560, 3, 2, 2, 2, 560, 562,
+	3, 2, 2, 2, 561, 563, 7, 22, 2, 2, 562, 561, 3, 2, 2, 2, 562, 563, 3, 2,
+	2, 2, 563, 564, 3, 2, 2, 2, 564, 565, 5, 536, 269, 2, 565, 3, 3, 2, 2,
+	2, 566, 568, 5, 10, 6, 2, 567, 569, 7, 22, 2, 2, 568, 567, 3, 2, 2, 2,
+	568, 569, 3, 2, 2, 2, 569, 570, 3, 2, 2, 2, 570, 571, 5, 6, 4, 2, 571,
+	574, 3, 2, 2, 2, 572, 574, 5, 8, 5, 2, 573, 566, 3, 2, 2, 2, 573, 572,
+	3, 2, 2, 2, 574, 577, 3, 2, 2, 2, 575, 573, 3, 2, 2, 2, 575, 576, 3, 2,
+	2, 2, 576, 586, 3, 2, 2, 2, 577, 575, 3, 2, 2, 2, 578, 583, 5, 10, 6, 2,
+	579, 581, 7, 22, 2, 2, 580, 579, 3, 2, 2, 2, 580, 581, 3, 2, 2, 2, 581,
+	582, 3, 2, 2, 2, 582, 584, 5, 6, 4, 2, 583, 580, 3, 2, 2, 2, 583, 584,
+	3, 2, 2, 2, 584, 587, 3, 2, 2, 2, 585, 587, 5, 8, 5, 2, 586, 578, 3, 2,
+	2, 2, 586, 585, 3, 2, 2, 2, 587, 5, 3, 2, 2, 2, 588, 589, 7, 10, 2, 2,
+	589, 7, 3, 2, 2, 2, 590, 591, 5, 6, 4, 2, 591, 9, 3, 2, 2, 2, 592, 630,
+	5, 190, 96, 2, 593, 630, 5, 116, 59, 2, 594, 630, 5, 110, 56, 2, 595, 630,
+	5, 90, 46, 2, 596, 630, 5, 76, 39, 2, 597, 630, 5, 70, 36, 2, 598, 630,
+	5, 184, 93, 2, 599, 630, 5, 56, 29, 2, 600, 630, 5, 48, 25, 2, 601, 630,
+	5, 202, 102, 2, 602, 630, 5, 46, 24, 2, 603, 630, 5, 36, 19, 2, 604, 630,
+	5, 28, 15, 2, 605, 630, 5, 138, 70, 2, 606, 630, 5, 34, 18, 2, 607, 630,
+	5, 30, 16, 2, 608, 630, 5, 26, 14, 2, 609, 630, 5, 214, 108, 2, 610, 630,
+	5, 124, 63, 2, 611, 630, 5, 126, 64, 2, 612, 630, 5, 136, 69, 2, 613, 630,
+	5, 134, 68, 2, 614, 630, 5, 122, 62, 2, 615, 630, 5, 130, 66, 2, 616, 630,
+	5, 132, 67, 2, 617, 630, 5, 128, 65, 2, 618, 630, 5, 120, 61, 2, 619, 630,
+	5, 118, 60, 2, 620, 630, 5, 20, 11, 2, 621, 630, 5, 238, 120, 2, 622, 630,
+	5, 18, 10, 2, 623, 630, 5, 16, 9, 2, 624, 630, 5, 12, 7, 2, 625, 630, 5,
+	260, 131, 2, 626, 630, 5, 200, 101, 2, 627, 630, 5, 220, 111, 2, 628, 630,
+	5, 198, 100, 2, 629, 592, 3, 2, 2, 2, 629, 593, 3, 2, 2, 2, 629, 594, 3,
+	2, 2, 2, 629, 595, 3, 2, 2, 2, 629, 596, 3, 2, 2, 2, 629, 597, 3, 2, 2,
+	2, 629, 598, 3, 2, 2, 2, 629, 599, 3, 2, 2, 2, 629, 600, 3, 2, 2, 2, 629,
+	601, 3, 2, 2, 2, 629, 602, 3, 2, 2, 2, 629, 603, 3, 2, 2, 2, 629, 604,
+	3, 2, 2, 2, 629, 605, 3, 2, 2, 2, 629, 606, 3, 2, 2, 2, 629, 607, 3, 2,
+	2, 2, 629, 608, 3, 2, 2, 2, 629, 609, 3, 2, 2, 2, 629, 610, 3, 2, 2, 2,
+	629, 611, 3, 2, 2, 2, 629, 612, 3, 2, 2, 2, 629, 613, 3, 2, 2, 2, 629,
+	614, 3, 2, 2, 2, 629, 615, 3, 2, 2, 2, 629, 616, 3, 2, 2, 2, 629, 617,
+	3, 2, 2, 2, 629, 618, 3, 2, 2, 2, 629, 619, 3, 2, 2, 2, 629, 620, 3, 2,
+	2, 2, 629, 621, 3, 2, 2, 2, 629, 622, 3, 2, 2, 2, 629, 623, 3, 2, 2, 2,
+	629, 624, 3, 2, 2, 2, 629, 625, 3, 2, 2, 2, 629, 626, 3, 2, 2, 2, 629,
+	627, 3, 2, 2, 2, 629, 628, 3, 2, 2, 2, 630, 11, 3, 2, 2, 2, 631, 632, 5,
+	534, 268, 2, 632, 633, 5, 22, 12, 2, 633, 634, 5, 464, 233, 2, 634, 635,
+	5, 24, 13, 2, 635, 636, 5, 406, 204, 2, 636, 637, 5, 320, 161, 2, 637,
+	13, 3, 2, 2, 2, 638, 639, 5, 444, 223, 2, 639, 640, 5, 522, 262, 2, 640,
+	15, 3, 2, 2, 2, 641, 642, 5, 444, 223, 2, 642, 646, 5, 486, 244, 2, 643,
+	644, 5, 462, 232, 2, 644, 645, 5, 320, 161, 2, 645, 647, 3, 2, 2, 2, 646,
+	643, 3, 2, 2, 2, 646, 647, 3, 2, 2, 2, 647, 649, 3, 2, 2, 2, 648, 650,
+	5, 456, 229, 2, 649, 648, 3, 2, 2, 2, 649, 650, 3, 2, 2, 2, 650, 17, 3,
+	2, 2, 2, 651, 652, 5, 444, 223, 2, 652, 656, 5, 22, 12, 2, 653, 654, 5,
+	464, 233, 2, 654, 655, 5, 24, 13, 2, 655, 657, 3, 2, 2, 2, 656, 653, 3,
+	2, 2, 2, 656, 657, 3, 2, 2, 2, 657, 661, 3, 2, 2, 2, 658, 659, 5, 462,
+	232, 2, 659, 660, 5, 320, 161, 2, 660, 662, 3, 2, 2, 2, 661, 658, 3, 2,
+	2, 2, 661, 662, 3, 2, 2, 2, 662, 19, 3, 2, 2, 2, 663, 664, 5, 414, 208,
+	2, 664, 665, 5, 22, 12, 2, 665, 666, 5, 464, 233, 2, 666, 667, 5, 24, 13,
+	2, 667, 668, 5, 504, 253, 2, 668, 669, 5, 320, 161, 2, 669, 21, 3, 2, 2,
+	2, 670, 673, 5, 350, 176, 2, 671, 673, 5, 352, 177, 2, 672, 670, 3, 2,
+	2, 2, 672, 671, 3, 2, 2, 2, 673, 683, 3, 2, 2, 2, 674, 683, 5, 356, 179,
+	2, 675, 683, 5, 366, 184, 2, 676, 683, 5, 388, 195, 2, 677, 683, 5, 398,
+	200, 2, 678, 683, 5, 382, 192, 2, 679, 683, 5, 392, 197, 2, 680, 683, 5,
+	452, 227, 2, 681, 683, 5, 488, 245, 2, 682, 672, 3, 2, 2, 2, 682, 674,
+	3, 2, 2, 2, 682, 675, 3, 2, 2, 2, 682, 676, 3, 2, 2, 2, 682, 677, 3, 2,
+	2, 2, 682, 678, 3, 2, 2, 2, 682, 679, 3, 2, 2, 2, 682, 680, 3, 2, 2, 2,
+	682, 681, 3, 2, 2, 2, 683, 23, 3, 2, 2, 2, 684, 685, 5, 350, 176, 2, 685,
+	686, 5, 412, 207, 2, 686, 723, 3, 2, 2, 2, 687, 688, 5, 350, 176, 2, 688,
+	689, 5, 412, 207, 2, 689, 690, 5, 418, 210, 2, 690, 691, 5, 436, 219, 2,
+	691, 692, 5, 306, 154, 2, 692, 723, 3, 2, 2, 2, 693, 697, 5, 410, 206,
+	2, 694, 695, 5, 306, 154, 2, 695, 696, 7, 17, 2, 2, 696, 698, 3, 2, 2,
+	2, 697, 694, 3, 2, 2, 2, 697, 698, 3, 2, 2, 2, 698, 699, 3, 2, 2, 2, 699,
+	700, 5, 332, 167, 2, 700, 723, 3, 2, 2, 2, 701, 702, 5, 350, 176, 2, 702,
+	703, 5, 438, 220, 2, 703, 723, 3, 2, 2, 2, 704, 705, 5, 436, 219, 2, 705,
+	706, 5, 306, 154, 2, 706, 723, 3, 2, 2, 2, 707, 709, 5, 500, 251, 2, 708,
+	707, 3, 2, 2, 2, 708, 709, 3, 2, 2, 2, 709, 713, 3, 2, 2, 2, 710, 711,
+	5, 306, 154, 2, 711, 712, 7, 17, 2, 2, 712, 714, 3, 2, 2, 2, 713, 710,
+	3, 2, 2, 2, 713, 714, 3, 2, 2, 2, 714, 715, 3, 2, 2, 2, 715, 723, 5, 308,
+	155, 2, 716, 717, 5, 350, 176, 2, 717, 718, 5, 486, 244, 2, 718, 723, 3,
+	2, 2, 2, 719, 720, 5, 484, 243, 2, 720, 721, 5, 320, 161, 2, 721, 723,
+	3, 2, 2, 2, 722, 684, 3, 2, 2, 2, 722, 687, 3, 2, 2, 2, 722, 693, 3, 2,
+	2, 2, 722, 701, 3, 2, 2, 2, 722, 704, 3, 2, 2, 2, 722, 708, 3, 2, 2, 2,
+	722, 716, 3, 2, 2, 2, 722, 719, 3, 2, 2, 2, 723, 25, 3, 2, 2, 2, 724, 725,
+	5, 382, 192, 2, 725, 727, 5, 520, 261, 2, 726, 728, 5, 248, 125, 2, 727,
+	726, 3, 2, 2, 2, 727, 728, 3, 2, 2, 2, 728, 729, 3, 2, 2, 2, 729, 730,
+	5, 336, 169, 2, 730, 731, 5, 532, 267, 2, 731, 732, 5, 472, 237, 2, 732,
+	735, 5, 300, 151, 2, 733, 736, 5, 498, 250, 2, 734, 736, 5, 454, 228, 2,
+	735, 733, 3, 2, 2, 2, 735, 734, 3, 2, 2, 2, 735, 736, 3, 2, 2, 2, 736,
+	27, 3, 2, 2, 2, 737, 738, 5, 382, 192, 2, 738, 740, 5, 484, 243, 2, 739,
+	741, 5, 248, 125, 2, 740, 739, 3, 2, 2, 2, 740, 741, 3, 2, 2, 2, 741, 742,
+	3, 2, 2, 2, 742, 744, 5, 320, 161, 2, 743, 745, 5, 112, 57, 2, 744, 743,
+	3, 2, 2, 2, 744, 745, 3, 2, 2, 2, 745, 29, 3, 2, 2, 2, 746, 747, 5, 382,
+	192, 2, 747, 749, 5, 512, 257, 2, 748, 750, 5, 248, 125, 2, 749, 748, 3,
+	2, 2, 2, 749, 750, 3, 2, 2, 2, 750, 754, 3, 2, 2, 2, 751, 752, 5, 306,
+	154, 2, 752, 753, 7, 17, 2, 2, 753, 755, 3, 2, 2, 2, 754, 751, 3, 2, 2,
+	2, 754, 755, 3, 2, 2, 2, 755, 756, 3, 2, 2, 2, 756, 757, 5, 328, 165, 2,
+	757, 758, 5, 538, 270, 2, 758, 759, 5, 32, 17, 2, 759, 760, 5, 540, 271,
+	2, 760, 31, 3, 2, 2, 2, 761, 762, 5, 310, 156, 2, 762, 769, 5, 312, 157,
+	2, 763, 764, 5, 554, 278, 2, 764, 765, 5, 310, 156, 2, 765, 766, 5, 312,
+	157, 2, 766, 768, 3, 2, 2, 2, 767, 763, 3, 2, 2, 2, 768, 771, 3, 2, 2,
+	2, 769, 767, 3, 2, 2, 2, 769, 770, 3, 2, 2, 2, 770, 33, 3, 2, 2, 2, 771,
+	769, 3, 2, 2, 2, 772, 773, 5, 382, 192, 2, 773, 775, 5, 506, 254, 2, 774,
+	776, 5, 248, 125, 2, 775, 774, 3, 2, 2, 2, 775, 776, 3, 2, 2, 2, 776, 780,
+	3, 2, 2, 2, 777, 778, 5, 306, 154, 2, 778, 779, 7, 17, 2, 2, 779, 781,
+	3, 2, 2, 2, 780, 777, 3, 2, 2, 2, 780, 781, 3, 2, 2, 2, 781, 782, 3, 2,
+	2, 2, 782, 783, 5, 322, 162, 2, 783, 784, 5, 524, 263, 2, 784, 785, 5,
+	324, 163, 2, 785, 35, 3, 2, 2, 2, 786, 787, 5, 382, 192, 2, 787, 788, 5,
+	450, 226, 2, 788, 790, 5, 528, 265, 2, 789, 791, 5, 248, 125, 2, 790, 789,
+	3, 2, 2, 2, 790, 791, 3, 2, 2, 2, 791, 795, 3, 2, 2, 2, 792, 793, 5, 306,
+	154, 2, 793, 794, 7, 17, 2, 2, 794, 796, 3, 2, 2, 2, 795, 792, 3, 2, 2,
+	2, 795, 796, 3, 2, 2, 2, 796, 797, 3, 2, 2, 2, 797, 798, 5, 326, 164, 2,
+	798, 799, 5, 362, 182, 2, 799, 2252, 5, 488, 245, 2, 800, 2251, 5, 256,
+	129, 2, 801, 805, 5, 406, 204, 2, 802, 803, 5, 306, 154, 2, 803, 804, 7,
+	17, 2, 2, 804, 806, 3, 2, 2, 2, 805, 802, 3, 2, 2, 2, 805, 806, 3, 2, 2,
+	2, 806, 807, 3, 2, 2, 2, 807, 808, 5, 308, 155, 2, 808, 809, 5, 38, 20,
+	2, 809, 810, 5, 474, 238, 2, 810, 811, 5, 432, 217, 2, 811, 812, 5, 538,
+	270, 2, 812, 813, 5, 168, 85, 2, 813, 817, 5, 540, 271, 2, 814, 815, 5,
+	532, 267, 2, 815, 816, 5, 44, 23, 2, 816, 818, 3, 2, 2, 2, 817, 814, 3,
+	2, 2, 2, 817, 818, 3, 2, 2, 2, 818, 37, 3, 2, 2, 2, 819, 820, 5, 530, 266,
+	2, 820, 824, 5, 40, 21, 2, 821, 822, 5, 358, 180, 2, 822, 823, 5, 282,
+	142, 2, 823, 825, 3, 2, 2, 2, 824, 821, 3, 2, 2, 2, 824, 825, 3, 2, 2,
+	2, 825, 39, 3, 2, 2, 2, 826, 832, 5, 42, 22, 2, 827, 828, 5, 358, 180,
+	2, 828, 829, 5, 42, 22, 2, 829, 831, 3, 2, 2, 2, 830, 827, 3, 2, 2, 2,
+	831, 834, 3, 2, 2, 2, 832, 830, 3, 2, 2, 2, 832, 833, 3, 2, 2, 2, 833,
+	41, 3, 2, 2, 2, 834, 832, 3, 2, 2, 2, 835, 836, 5, 310, 156, 2, 836, 837,
+	5, 430, 216, 2, 837, 838, 5, 458, 230, 2, 838, 839, 5, 460, 231, 2, 839,
+	43, 3, 2, 2, 2, 840, 2279, 5, 144, 73, 2, 841, 844, 5, 358, 180, 2, 842,
+	843, 5, 358, 180, 2, 843, 2281, 5, 142, 72, 2, 844, 2282, 5, 144, 73, 2,
+	845, 2283, 5, 142, 72, 2, 846, 840, 3, 2, 2, 2, 846, 845, 3, 2, 2, 2, 847,
+	45, 3, 2, 2, 2, 848, 849, 5, 382, 192, 2, 849, 851, 5, 436, 219, 2, 850,
+	852, 5, 248, 125, 2, 851, 850, 3, 2, 2, 2, 851, 852, 3, 2, 2, 2, 852, 853,
+	3, 2, 2, 2, 853, 854, 5, 306, 154, 2, 854, 855, 5, 532, 267, 2, 855, 856,
 	5, 480, 241, 2, 856, 857, 7, 173, 2, 2, 857, 858, 5, 542, 272, 2, 858,
 	859, 5, 192, 97, 2, 859, 863, 5, 544, 273, 2, 860, 861, 5, 358, 180, 2,
 	861, 862, 5, 196, 99, 2, 862, 864, 3, 2, 2, 2, 863, 860, 3, 2, 2, 2, 863,
@@ -591,7 +597,7 @@ var parserATN = []uint16{
 	1098, 5, 554, 278, 2, 1098, 1099, 5, 108, 55, 2, 1099, 1101, 3, 2, 2, 2,
 	1100, 1097, 3, 2, 2, 2, 1101, 1104, 3, 2, 2, 2, 1102, 1100, 3, 2, 2, 2,
 	1102, 1103, 3, 2, 2, 2, 1103, 107, 3, 2, 2, 2, 1104, 1102, 3, 2, 2, 2,
-	1105, 1106, 5, 310, 156, 2, 1106, 1107, 5, 312, 157, 2, 1107, 109, 3, 2,
+	1105, 1106, 5, 310, 156, 2, 1106, 2263, 5, 312, 157, 2, 1107, 109, 3, 2,
 	2, 2, 1108, 1109, 5, 356, 179, 2, 1109, 1110, 5, 484, 243, 2, 1110, 1112,
 	5, 320, 161, 2, 1111, 1113, 5, 112, 57, 2, 1112, 1111, 3, 2, 2, 2, 1112,
 	1113, 3, 2, 2, 2, 1113, 111, 3, 2, 2, 2, 1114, 1115, 5, 532, 267, 2, 1115,
@@ -662,13 +668,13 @@ var parserATN = []uint16{
 	2, 2, 1273, 1274, 3, 2, 2, 2, 1274, 1275, 5, 308, 155, 2, 1275, 1276, 5,
 	538, 270, 2, 1276, 1277, 5, 160, 81, 2, 1277, 1279, 5, 540, 271, 2, 1278,
 	1280, 5, 140, 71, 2, 1279, 1278, 3, 2, 2, 2, 1279, 1280, 3, 2, 2, 2, 1280,
-	139, 3, 2, 2, 2, 1281, 1283, 5, 532, 267, 2, 1282, 1284, 5, 144, 73, 2,
-	1283, 1282, 3, 2, 2, 2, 1283, 1284, 3, 2, 2, 2, 1284, 1286, 3, 2, 2, 2,
-	1285, 1287, 5, 142, 72, 2, 1286, 1285, 3, 2, 2, 2, 1286, 1287, 3, 2, 2,
-	2, 1287, 141, 3, 2, 2, 2, 1288, 1289, 5, 376, 189, 2, 1289, 1290, 5, 470,
+	139, 3, 2, 2, 2, 1281, 1283, 5, 532, 267, 2, 1282, 1286, 5, 144, 73, 2,
+	1283, 1282, 3, 2, 2, 2, 1283, 1285, 3, 2, 2, 2, 1284, 141, 3, 2, 2, 2,
+	1285, 2271, 5, 142, 72, 2, 1286, 2264, 3, 2, 2, 2, 1286, 1287, 3, 2, 2,
+	2, 1287, 1284, 3, 2, 2, 2, 1288, 1289, 5, 376, 189, 2, 1289, 1290, 5, 470,
 	236, 2, 1290, 1291, 5, 372, 187, 2, 1291, 1292, 5, 538, 270, 2, 1292, 1294,
 	5, 310, 156, 2, 1293, 1295, 5, 318, 160, 2, 1294, 1293, 3, 2, 2, 2, 1294,
-	1295, 3, 2, 2, 2, 1295, 1296, 3, 2, 2, 2, 1296, 1297, 5, 540, 271, 2, 1297,
+	1295, 3, 2, 2, 2, 1295, 2245, 3, 2, 2, 2, 1296, 1297, 5, 540, 271, 2, 1297,
 	143, 3, 2, 2, 2, 1298, 1304, 5, 146, 74, 2, 1299, 1300, 5, 358, 180, 2,
 	1300, 1301, 5, 146, 74, 2, 1301, 1303, 3, 2, 2, 2, 1302, 1299, 3, 2, 2,
 	2, 1303, 1306, 3, 2, 2, 2, 1304, 1302, 3, 2, 2, 2, 1304, 1305, 3, 2, 2,
@@ -693,7 +699,7 @@ var parserATN = []uint16{
 	2, 1352, 1357, 3, 2, 2, 2, 1353, 1351, 3, 2, 2, 2, 1354, 1355, 5, 554,
 	278, 2, 1355, 1356, 5, 166, 84, 2, 1356, 1358, 3, 2, 2, 2, 1357, 1354,
 	3, 2, 2, 2, 1357, 1358, 3, 2, 2, 2, 1358, 161, 3, 2, 2, 2, 1359, 1360,
-	5, 310, 156, 2, 1360, 1362, 5, 312, 157, 2, 1361, 1363, 5, 164, 83, 2,
+	5, 310, 156, 2, 1360, 2260, 5, 312, 157, 2, 1361, 1363, 5, 164, 83, 2,
 	1362, 1361, 3, 2, 2, 2, 1362, 1363, 3, 2, 2, 2, 1363, 163, 3, 2, 2, 2,
 	1364, 1365, 5, 474, 238, 2, 1365, 1366, 5, 432, 217, 2, 1366, 165, 3, 2,
 	2, 2, 1367, 1368, 5, 474, 238, 2, 1368, 1369, 5, 432, 217, 2, 1369, 1370,
@@ -703,8 +709,8 @@ var parserATN = []uint16{
 	2, 2, 1376, 1375, 3, 2, 2, 2, 1377, 169, 3, 2, 2, 2, 1378, 1379, 5, 310,
 	156, 2, 1379, 171, 3, 2, 2, 2, 1380, 1381, 5, 180, 91, 2, 1381, 1382, 5,
 	554, 278, 2, 1382, 1383, 5, 178, 90, 2, 1383, 173, 3, 2, 2, 2, 1384, 1385,
-	5, 538, 270, 2, 1385, 1386, 5, 176, 89, 2, 1386, 1387, 5, 540, 271, 2,
-	1387, 1388, 5, 554, 278, 2, 1388, 1389, 5, 178, 90, 2, 1389, 175, 3, 2,
+	5, 538, 270, 2, 1385, 1386, 5, 176, 89, 2, 1386, 2240, 5, 540, 271, 2,
+	1387, 1388, 5, 554, 278, 2, 1388, 2239, 5, 178, 90, 2, 1389, 175, 3, 2,
 	2, 2, 1390, 1396, 5, 180, 91, 2, 1391, 1392, 5, 554, 278, 2, 1392, 1393,
 	5, 180, 91, 2, 1393, 1395, 3, 2, 2, 2, 1394, 1391, 3, 2, 2, 2, 1395, 1398,
 	3, 2, 2, 2, 1396, 1394, 3, 2, 2, 2, 1396, 1397, 3, 2, 2, 2, 1397, 177,
@@ -946,8 +952,8 @@ var parserATN = []uint16{
 	2, 2, 1974, 1971, 3, 2, 2, 2, 1975, 311, 3, 2, 2, 2, 1976, 1978, 5, 314,
 	158, 2, 1977, 1979, 5, 316, 159, 2, 1978, 1977, 3, 2, 2, 2, 1978, 1979,
 	3, 2, 2, 2, 1979, 313, 3, 2, 2, 2, 1980, 1981, 9, 6, 2, 2, 1981, 315, 3,
-	2, 2, 2, 1982, 1983, 5, 546, 274, 2, 1983, 1989, 5, 314, 158, 2, 1984,
-	1985, 5, 554, 278, 2, 1985, 1986, 5, 314, 158, 2, 1986, 1988, 3, 2, 2,
+	2, 2, 2, 1982, 1983, 5, 546, 274, 2, 1983, 1989, 5, 312, 157, 2, 1984,
+	1985, 5, 554, 278, 2, 1985, 1986, 5, 312, 157, 2, 1986, 1988, 3, 2, 2,
 	2, 1987, 1984, 3, 2, 2, 2, 1988, 1991, 3, 2, 2, 2, 1989, 1987, 3, 2, 2,
 	2, 1989, 1990, 3, 2, 2, 2, 1990, 1992, 3, 2, 2, 2, 1991, 1989, 3, 2, 2,
 	2, 1992, 1993, 5, 548, 275, 2, 1993, 317, 3, 2, 2, 2, 1994, 1997, 5, 364,
@@ -1032,20 +1038,41 @@ var parserATN = []uint16{
 	2227, 547, 3, 2, 2, 2, 2228, 2229, 7, 175, 2, 2, 2229, 549, 3, 2, 2, 2,
 	2230, 2231, 7, 7, 2, 2, 2231, 551, 3, 2, 2, 2, 2232, 2233, 7, 8, 2, 2,
 	2233, 553, 3, 2, 2, 2, 2234, 2235, 7, 9, 2, 2, 2235, 555, 3, 2, 2, 2, 2236,
-	2237, 7, 11, 2, 2, 2237, 557, 3, 2, 2, 2, 169, 559, 562, 568, 573, 575,
-	580, 583, 586, 629, 646, 649, 656, 661, 672, 682, 697, 708, 713, 722, 727,
-	735, 740, 744, 749, 754, 769, 775, 780, 790, 795, 805, 817, 824, 832, 846,
-	851, 863, 867, 871, 876, 881, 900, 907, 915, 919, 924, 943, 952, 967, 969,
-	981, 995, 1002, 1009, 1017, 1028, 1044, 1057, 1067, 1090, 1102, 1112, 1121,
-	1140, 1148, 1154, 1159, 1166, 1171, 1179, 1184, 1191, 1196, 1203, 1208,
-	1215, 1222, 1229, 1236, 1241, 1248, 1255, 1260, 1267, 1272, 1279, 1283,
-	1286, 1294, 1304, 1315, 1321, 1330, 1343, 1351, 1357, 1362, 1376, 1396,
-	1405, 1417, 1421, 1425, 1439, 1447, 1456, 1467, 1472, 1479, 1482, 1488,
-	1497, 1503, 1521, 1525, 1529, 1534, 1542, 1550, 1554, 1557, 1563, 1567,
-	1574, 1585, 1598, 1607, 1654, 1663, 1679, 1691, 1698, 1706, 1713, 1716,
-	1734, 1767, 1774, 1781, 1785, 1791, 1796, 1799, 1802, 1805, 1820, 1829,
-	1838, 1845, 1855, 1861, 1863, 1871, 1894, 1900, 1919, 1922, 1927, 1933,
-	1937, 1946, 1962, 1968, 1974, 1978, 1989, 1996,
+	2237, 7, 11, 2, 2, 2237, 557, 3, 2, 2, 2, 2239, 1389, 3, 2, 2, 2, 2240,
+	1387, 3, 2, 2, 2, 2240, 2239, 3, 2, 2, 2, 2241, 2243, 3, 2, 2, 2, 2242,
+	2246, 3, 2, 2, 2, 2243, 2245, 3, 2, 2, 2, 2244, 1296, 3, 2, 2, 2, 2245,
+	2242, 3, 2, 2, 2, 2245, 2244, 3, 2, 2, 2, 2246, 2247, 5, 554, 278, 2, 2247,
+	2249, 5, 310, 156, 2, 2248, 2241, 3, 2, 2, 2, 2249, 2250, 3, 2, 2, 2, 2249,
+	2248, 3, 2, 2, 2, 2250, 2248, 5, 318, 160, 2, 2251, 801, 3, 2, 2, 2, 2252,
+	2253, 3, 2, 2, 2, 2252, 800, 3, 2, 2, 2, 2253, 2251, 7, 18, 2, 2, 2254,
+	2256, 3, 2, 2, 2, 2256, 2257, 7, 117, 2, 2, 2257, 2255, 3, 2, 2, 2, 2258,
+	2259, 5, 2254, 280, 2, 2259, 1362, 3, 2, 2, 2, 2260, 2258, 3, 2, 2, 2,
+	2260, 2259, 3, 2, 2, 2, 2261, 2262, 5, 2254, 280, 2, 2262, 1107, 3, 2,
+	2, 2, 2263, 2261, 3, 2, 2, 2, 2263, 2262, 3, 2, 2, 2, 2264, 2265, 5, 358,
+	180, 2, 2265, 2267, 5, 142, 72, 2, 2266, 1287, 3, 2, 2, 2, 2267, 2268,
+	3, 2, 2, 2, 2267, 2266, 3, 2, 2, 2, 2268, 2269, 5, 358, 180, 2, 2269, 2266,
+	5, 144, 73, 2, 2270, 1284, 3, 2, 2, 2, 2271, 2272, 3, 2, 2, 2, 2271, 2270,
+	3, 2, 2, 2, 2272, 2273, 5, 358, 180, 2, 2273, 2270, 5, 144, 73, 2, 2274,
+	2276, 3, 2, 2, 2, 2274, 2275, 3, 2, 2, 2, 2275, 1976, 3, 2, 2, 2, 2276,
+	2277, 5, 306, 154, 2, 2277, 2278, 7, 17, 2, 2, 2278, 2275, 3, 2, 2, 2,
+	2279, 842, 3, 2, 2, 2, 2279, 2280, 3, 2, 2, 2, 2280, 847, 3, 2, 2, 2, 2281,
+	841, 3, 2, 2, 2, 2281, 2282, 3, 2, 2, 2, 2282, 2280, 3, 2, 2, 2, 2283,
+	2285, 3, 2, 2, 2, 2283, 2284, 3, 2, 2, 2, 2284, 847, 3, 2, 2, 2, 2285,
+	2286, 5, 358, 180, 2, 2286, 2284, 5, 144, 73, 2, 181, 559, 562, 568, 573,
+	575, 580, 583, 586, 629, 646, 649, 656, 661, 672, 682, 697, 708, 713, 722,
+	727, 735, 740, 744, 749, 754, 769, 775, 780, 790, 795, 805, 817, 824, 832,
+	846, 851, 863, 867, 871, 876, 881, 900, 907, 915, 919, 924, 943, 952, 967,
+	969, 981, 995, 1002, 1009, 1017, 1028, 1044, 1057, 1067, 1090, 1102, 1112,
+	1121, 1140, 1148, 1154, 1159, 1166, 1171, 1179, 1184, 1191, 1196, 1203,
+	1208, 1215, 1222, 1229, 1236, 1241, 1248, 1255, 1260, 1267, 1272, 1279,
+	1283, 1286, 1294, 1304, 1315, 1321, 1330, 1343, 1351, 1357, 1362, 1376,
+	1396, 1405, 1417, 1421, 1425, 1439, 1447, 1456, 1467, 1472, 1479, 1482,
+	1488, 1497, 1503, 1521, 1525, 1529, 1534, 1542, 1550, 1554, 1557, 1563,
+	1567, 1574, 1585, 1598, 1607, 1654, 1663, 1679, 1691, 1698, 1706, 1713,
+	1716, 1734, 1767, 1774, 1781, 1785, 1791, 1796, 1799, 1802, 1805, 1820,
+	1829, 1838, 1845, 1855, 1861, 1863, 1871, 1894, 1900, 1919, 1922, 1927,
+	1933, 1937, 1946, 1962, 1968, 1974, 1978, 1989, 1996, 2240, 2245, 2249,
+	2252, 2260, 2263, 2267, 2271, 2274, 2279, 2281, 2283,
 }
 var deserializer = antlr.NewATNDeserializer(nil)
 var deserializedATN = deserializer.DeserializeFromUInt16(parserATN)
@@ -1140,7 +1167,7 @@ var ruleNames = []string{
 	"kwUnlogged", "kwUpdate", "kwUse", "kwUser", "kwUsers", "kwUsing", "kwValues",
 	"kwView", "kwWhere", "kwWith", "kwRevoke", "eof", "syntaxBracketLr", "syntaxBracketRr",
 	"syntaxBracketLc", "syntaxBracketRc", "syntaxBracketLa", "syntaxBracketRa",
-	"syntaxBracketLs", "syntaxBracketRs", "syntaxComma", "syntaxColon",
+	"syntaxBracketLs", "syntaxBracketRs", "syntaxComma", "syntaxColon", "kwStatic",
 }
 var decisionToDFA = make([]*antlr.DFA, len(deserializedATN.DecisionToState))
 
@@ -1629,6 +1656,7 @@ const (
 	CqlParserRULE_syntaxBracketRs              = 275
 	CqlParserRULE_syntaxComma                  = 276
 	CqlParserRULE_syntaxColon                  = 277
+	CqlParserRULE_kwStatic                     = 278
 )
 
 // IRootContext is an interface to support dynamic dispatch.
@@ -5430,29 +5458,6 @@ func (s *CreateMaterializedViewContext) KwSelect() IKwSelectContext {
 	return t.(IKwSelectContext)
 }
 
-func (s *CreateMaterializedViewContext) AllColumnList() []IColumnListContext {
-	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IColumnListContext)(nil)).Elem())
-	var tst = make([]IColumnListContext, len(ts))
-
-	for i, t := range ts {
-		if t != nil {
-			tst[i] = t.(IColumnListContext)
-		}
-	}
-
-	return tst
-}
-
-func (s *CreateMaterializedViewContext) ColumnList(i int) IColumnListContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IColumnListContext)(nil)).Elem(), i)
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IColumnListContext)
-}
-
 func (s *CreateMaterializedViewContext) KwFrom() IKwFromContext {
 	var t = s.GetTypedRuleContext(reflect.TypeOf((*IKwFromContext)(nil)).Elem(), 0)
 
@@ -5513,6 +5518,16 @@ func (s *CreateMaterializedViewContext) SyntaxBracketLr() ISyntaxBracketLrContex
 	return t.(ISyntaxBracketLrContext)
 }
 
+func (s *CreateMaterializedViewContext) PrimaryKeyDefinition() IPrimaryKeyDefinitionContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IPrimaryKeyDefinitionContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IPrimaryKeyDefinitionContext)
+}
+
 func (s *CreateMaterializedViewContext) SyntaxBracketRr() ISyntaxBracketRrContext {
 	var t = s.GetTypedRuleContext(reflect.TypeOf((*ISyntaxBracketRrContext)(nil)).Elem(), 0)
 
@@ -5564,6 +5579,20 @@ func (s *CreateMaterializedViewContext) DOT(i int) antlr.TerminalNode {
 	return s.GetToken(CqlParserDOT, i)
 }
 
+func (s *CreateMaterializedViewContext) STAR() antlr.TerminalNode {
+	return s.GetToken(CqlParserSTAR, 0)
+}
+
+func (s *CreateMaterializedViewContext) ColumnList() IColumnListContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IColumnListContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IColumnListContext)
+}
+
 func (s *CreateMaterializedViewContext) KwWith() IKwWithContext {
 	var t = s.GetTypedRuleContext(reflect.TypeOf((*IKwWithContext)(nil)).Elem(), 0)
 
@@ -5675,9 +5704,24 @@ func (p *CqlParser) CreateMaterializedView() (localctx ICreateMaterializedViewCo
 		p.SetState(797)
 		p.KwSelect()
 	}
-	{
-		p.SetState(798)
-		p.ColumnList()
+	p.SetState(2250)
+	p.GetErrorHandler().Sync(p)
+
+	switch p.GetTokenStream().LA(1) {
+	case CqlParserSTAR:
+		{
+			p.SetState(2251)
+			p.Match(CqlParserSTAR)
+		}
+
+	case CqlParserDQUOTE, CqlParserOBJECT_NAME:
+		{
+			p.SetState(798)
+			p.ColumnList()
+		}
+
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 	}
 	{
 		p.SetState(799)
@@ -5719,7 +5763,7 @@ func (p *CqlParser) CreateMaterializedView() (localctx ICreateMaterializedViewCo
 	}
 	{
 		p.SetState(810)
-		p.ColumnList()
+		p.PrimaryKeyDefinition()
 	}
 	{
 		p.SetState(811)
@@ -6223,8 +6267,21 @@ func NewMaterializedViewOptionsContext(parser antlr.Parser, parent antlr.ParserR
 
 func (s *MaterializedViewOptionsContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *MaterializedViewOptionsContext) TableOptions() ITableOptionsContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*ITableOptionsContext)(nil)).Elem(), 0)
+func (s *MaterializedViewOptionsContext) AllTableOptions() []ITableOptionsContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*ITableOptionsContext)(nil)).Elem())
+	var tst = make([]ITableOptionsContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(ITableOptionsContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *MaterializedViewOptionsContext) TableOptions(i int) ITableOptionsContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ITableOptionsContext)(nil)).Elem(), i)
 
 	if t == nil {
 		return nil
@@ -6233,8 +6290,21 @@ func (s *MaterializedViewOptionsContext) TableOptions() ITableOptionsContext {
 	return t.(ITableOptionsContext)
 }
 
-func (s *MaterializedViewOptionsContext) KwAnd() IKwAndContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IKwAndContext)(nil)).Elem(), 0)
+func (s *MaterializedViewOptionsContext) AllKwAnd() []IKwAndContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IKwAndContext)(nil)).Elem())
+	var tst = make([]IKwAndContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IKwAndContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *MaterializedViewOptionsContext) KwAnd(i int) IKwAndContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IKwAndContext)(nil)).Elem(), i)
 
 	if t == nil {
 		return nil
@@ -6276,6 +6346,7 @@ func (s *MaterializedViewOptionsContext) ExitRule(listener antlr.ParseTreeListen
 func (p *CqlParser) MaterializedViewOptions() (localctx IMaterializedViewOptionsContext) {
 	localctx = NewMaterializedViewOptionsContext(p, p.GetParserRuleContext(), p.GetState())
 	p.EnterRule(localctx, 42, CqlParserRULE_materializedViewOptions)
+	var _la int
 
 	defer func() {
 		p.ExitRule()
@@ -6295,36 +6366,69 @@ func (p *CqlParser) MaterializedViewOptions() (localctx IMaterializedViewOptions
 
 	p.SetState(844)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 34, p.GetParserRuleContext()) {
-	case 1:
+
+	switch p.GetTokenStream().LA(1) {
+	case CqlParserOBJECT_NAME:
 		p.EnterOuterAlt(localctx, 1)
 		{
 			p.SetState(838)
 			p.TableOptions()
 		}
+		p.SetState(2277)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == CqlParserK_AND {
+			{
+				p.SetState(840)
+				p.KwAnd()
+			}
+			{
+				p.SetState(841)
+				p.ClusteringOrder()
+			}
+			p.SetState(2279)
+			p.GetErrorHandler().Sync(p)
+			_la = p.GetTokenStream().LA(1)
+
+			if _la == CqlParserK_AND {
+				{
+					p.SetState(839)
+					p.KwAnd()
+				}
+				{
+					p.SetState(842)
+					p.TableOptions()
+				}
+
+			}
 
-	case 2:
-		p.EnterOuterAlt(localctx, 2)
-		{
-			p.SetState(839)
-			p.TableOptions()
-		}
-		{
-			p.SetState(840)
-			p.KwAnd()
-		}
-		{
-			p.SetState(841)
-			p.ClusteringOrder()
 		}
 
-	case 3:
-		p.EnterOuterAlt(localctx, 3)
+	case CqlParserK_CLUSTERING:
+		p.EnterOuterAlt(localctx, 2)
 		{
 			p.SetState(843)
 			p.ClusteringOrder()
 		}
+		p.SetState(2281)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == CqlParserK_AND {
+			{
+				p.SetState(2283)
+				p.KwAnd()
+			}
+			{
+				p.SetState(2284)
+				p.TableOptions()
+			}
+
+		}
 
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 	}
 
 	return localctx
@@ -11418,6 +11522,16 @@ func (s *AlterTableColumnDefinitionContext) DataType() IDataTypeContext {
 	return t.(IDataTypeContext)
 }
 
+func (s *AlterTableColumnDefinitionContext) KwStatic() IKwStaticContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IKwStaticContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IKwStaticContext)
+}
+
 func (s *AlterTableColumnDefinitionContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
@@ -11441,6 +11555,7 @@ func (s *AlterTableColumnDefinitionContext) ExitRule(listener antlr.ParseTreeLis
 func (p *CqlParser) AlterTableColumnDefinition() (localctx IAlterTableColumnDefinitionContext) {
 	localctx = NewAlterTableColumnDefinitionContext(p, p.GetParserRuleContext(), p.GetState())
 	p.EnterRule(localctx, 106, CqlParserRULE_alterTableColumnDefinition)
+	var _la int
 
 	defer func() {
 		p.ExitRule()
@@ -11467,6 +11582,17 @@ func (p *CqlParser) AlterTableColumnDefinition() (localctx IAlterTableColumnDefi
 		p.SetState(1104)
 		p.DataType()
 	}
+	p.SetState(2261)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == CqlParserK_STATIC {
+		{
+			p.SetState(2259)
+			p.KwStatic()
+		}
+
+	}
 
 	return localctx
 }
@@ -14205,8 +14331,21 @@ func (s *WithElementContext) KwWith() IKwWithContext {
 	return t.(IKwWithContext)
 }
 
-func (s *WithElementContext) TableOptions() ITableOptionsContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*ITableOptionsContext)(nil)).Elem(), 0)
+func (s *WithElementContext) AllTableOptions() []ITableOptionsContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*ITableOptionsContext)(nil)).Elem())
+	var tst = make([]ITableOptionsContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(ITableOptionsContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *WithElementContext) TableOptions(i int) ITableOptionsContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ITableOptionsContext)(nil)).Elem(), i)
 
 	if t == nil {
 		return nil
@@ -14215,6 +14354,29 @@ func (s *WithElementContext) TableOptions() ITableOptionsContext {
 	return t.(ITableOptionsContext)
 }
 
+func (s *WithElementContext) AllKwAnd() []IKwAndContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IKwAndContext)(nil)).Elem())
+	var tst = make([]IKwAndContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IKwAndContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *WithElementContext) KwAnd(i int) IKwAndContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IKwAndContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IKwAndContext)
+}
+
 func (s *WithElementContext) ClusteringOrder() IClusteringOrderContext {
 	var t = s.GetTypedRuleContext(reflect.TypeOf((*IClusteringOrderContext)(nil)).Elem(), 0)
 
@@ -14273,25 +14435,67 @@ func (p *CqlParser) WithElement() (localctx IWithElementContext) {
 	}
 	p.SetState(1281)
 	p.GetErrorHandler().Sync(p)
-	_la = p.GetTokenStream().LA(1)
 
-	if _la == CqlParserOBJECT_NAME {
+	switch p.GetTokenStream().LA(1) {
+	case CqlParserOBJECT_NAME:
 		{
 			p.SetState(1280)
 			p.TableOptions()
 		}
+		p.SetState(1284)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
 
-	}
-	p.SetState(1284)
-	p.GetErrorHandler().Sync(p)
-	_la = p.GetTokenStream().LA(1)
+		if _la == CqlParserK_AND {
+			{
+				p.SetState(2262)
+				p.KwAnd()
+			}
+			{
+				p.SetState(2263)
+				p.ClusteringOrder()
+			}
+			p.SetState(2265)
+			p.GetErrorHandler().Sync(p)
+			_la = p.GetTokenStream().LA(1)
+
+			if _la == CqlParserK_AND {
+				{
+					p.SetState(2266)
+					p.KwAnd()
+				}
+				{
+					p.SetState(2267)
+					p.TableOptions()
+				}
 
-	if _la == CqlParserK_CLUSTERING {
+			}
+
+		}
+
+	case CqlParserK_CLUSTERING:
 		{
 			p.SetState(1283)
 			p.ClusteringOrder()
 		}
+		p.SetState(2269)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == CqlParserK_AND {
+			{
+				p.SetState(2270)
+				p.KwAnd()
+			}
+			{
+				p.SetState(2271)
+				p.TableOptions()
+			}
+
+		}
 
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 	}
 
 	return localctx
@@ -14375,8 +14579,21 @@ func (s *ClusteringOrderContext) SyntaxBracketLr() ISyntaxBracketLrContext {
 	return t.(ISyntaxBracketLrContext)
 }
 
-func (s *ClusteringOrderContext) Column() IColumnContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IColumnContext)(nil)).Elem(), 0)
+func (s *ClusteringOrderContext) AllColumn() []IColumnContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IColumnContext)(nil)).Elem())
+	var tst = make([]IColumnContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IColumnContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *ClusteringOrderContext) Column(i int) IColumnContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IColumnContext)(nil)).Elem(), i)
 
 	if t == nil {
 		return nil
@@ -14395,8 +14612,21 @@ func (s *ClusteringOrderContext) SyntaxBracketRr() ISyntaxBracketRrContext {
 	return t.(ISyntaxBracketRrContext)
 }
 
-func (s *ClusteringOrderContext) OrderDirection() IOrderDirectionContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IOrderDirectionContext)(nil)).Elem(), 0)
+func (s *ClusteringOrderContext) AllOrderDirection() []IOrderDirectionContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IOrderDirectionContext)(nil)).Elem())
+	var tst = make([]IOrderDirectionContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IOrderDirectionContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *ClusteringOrderContext) OrderDirection(i int) IOrderDirectionContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IOrderDirectionContext)(nil)).Elem(), i)
 
 	if t == nil {
 		return nil
@@ -14405,6 +14635,29 @@ func (s *ClusteringOrderContext) OrderDirection() IOrderDirectionContext {
 	return t.(IOrderDirectionContext)
 }
 
+func (s *ClusteringOrderContext) AllSyntaxComma() []ISyntaxCommaContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*ISyntaxCommaContext)(nil)).Elem())
+	var tst = make([]ISyntaxCommaContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(ISyntaxCommaContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *ClusteringOrderContext) SyntaxComma(i int) ISyntaxCommaContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ISyntaxCommaContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ISyntaxCommaContext)
+}
+
 func (s *ClusteringOrderContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
@@ -14478,6 +14731,35 @@ func (p *CqlParser) ClusteringOrder() (localctx IClusteringOrderContext) {
 		}
 
 	}
+	p.SetState(2243)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	for _la == CqlParserCOMMA {
+		{
+			p.SetState(2244)
+			p.SyntaxComma()
+		}
+		{
+			p.SetState(2245)
+			p.Column()
+		}
+		p.SetState(2247)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == CqlParserK_ASC || _la == CqlParserK_DESC {
+			{
+				p.SetState(2248)
+				p.OrderDirection()
+			}
+
+		}
+
+		p.SetState(2241)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+	}
 	{
 		p.SetState(1294)
 		p.SyntaxBracketRr()
@@ -15764,6 +16046,16 @@ func (s *ColumnDefinitionContext) DataType() IDataTypeContext {
 	return t.(IDataTypeContext)
 }
 
+func (s *ColumnDefinitionContext) KwStatic() IKwStaticContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IKwStaticContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IKwStaticContext)
+}
+
 func (s *ColumnDefinitionContext) PrimaryKeyColumn() IPrimaryKeyColumnContext {
 	var t = s.GetTypedRuleContext(reflect.TypeOf((*IPrimaryKeyColumnContext)(nil)).Elem(), 0)
 
@@ -15824,6 +16116,17 @@ func (p *CqlParser) ColumnDefinition() (localctx IColumnDefinitionContext) {
 		p.SetState(1358)
 		p.DataType()
 	}
+	p.SetState(2258)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == CqlParserK_STATIC {
+		{
+			p.SetState(2256)
+			p.KwStatic()
+		}
+
+	}
 	p.SetState(1360)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
@@ -16574,6 +16877,7 @@ func (s *CompositeKeyContext) ExitRule(listener antlr.ParseTreeListener) {
 func (p *CqlParser) CompositeKey() (localctx ICompositeKeyContext) {
 	localctx = NewCompositeKeyContext(p, p.GetParserRuleContext(), p.GetState())
 	p.EnterRule(localctx, 172, CqlParserRULE_compositeKey)
+	var _la int
 
 	defer func() {
 		p.ExitRule()
@@ -16604,14 +16908,20 @@ func (p *CqlParser) CompositeKey() (localctx ICompositeKeyContext) {
 		p.SetState(1384)
 		p.SyntaxBracketRr()
 	}
+	p.SetState(2238)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == CqlParserCOMMA {
+		{
+			p.SetState(1385)
+			p.SyntaxComma()
+		}
+		{
+			p.SetState(1386)
+			p.ClusteringKeyList()
+		}
 
-	{
-		p.SetState(1385)
-		p.SyntaxComma()
-	}
-	{
-		p.SetState(1386)
-		p.ClusteringKeyList()
 	}
 
 	return localctx
@@ -27084,6 +27394,20 @@ func (s *DataTypeContext) DataTypeDefinition() IDataTypeDefinitionContext {
 	return t.(IDataTypeDefinitionContext)
 }
 
+func (s *DataTypeContext) Keyspace() IKeyspaceContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IKeyspaceContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IKeyspaceContext)
+}
+
+func (s *DataTypeContext) DOT() antlr.TerminalNode {
+	return s.GetToken(CqlParserDOT, 0)
+}
+
 func (s *DataTypeContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
@@ -27126,6 +27450,20 @@ func (p *CqlParser) DataType() (localctx IDataTypeContext) {
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
+	p.SetState(2272)
+	p.GetErrorHandler().Sync(p)
+
+	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 175, p.GetParserRuleContext()) == 1 {
+		{
+			p.SetState(2274)
+			p.Keyspace()
+		}
+		{
+			p.SetState(2275)
+			p.Match(CqlParserDOT)
+		}
+
+	}
 	{
 		p.SetState(1974)
 		p.DataTypeName()
@@ -27392,27 +27730,27 @@ func (s *DataTypeDefinitionContext) SyntaxBracketLa() ISyntaxBracketLaContext {
 	return t.(ISyntaxBracketLaContext)
 }
 
-func (s *DataTypeDefinitionContext) AllDataTypeName() []IDataTypeNameContext {
-	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IDataTypeNameContext)(nil)).Elem())
-	var tst = make([]IDataTypeNameContext, len(ts))
+func (s *DataTypeDefinitionContext) AllDataType() []IDataTypeContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IDataTypeContext)(nil)).Elem())
+	var tst = make([]IDataTypeContext, len(ts))
 
 	for i, t := range ts {
 		if t != nil {
-			tst[i] = t.(IDataTypeNameContext)
+			tst[i] = t.(IDataTypeContext)
 		}
 	}
 
 	return tst
 }
 
-func (s *DataTypeDefinitionContext) DataTypeName(i int) IDataTypeNameContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IDataTypeNameContext)(nil)).Elem(), i)
+func (s *DataTypeDefinitionContext) DataType(i int) IDataTypeContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IDataTypeContext)(nil)).Elem(), i)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IDataTypeNameContext)
+	return t.(IDataTypeContext)
 }
 
 func (s *DataTypeDefinitionContext) SyntaxBracketRa() ISyntaxBracketRaContext {
@@ -27496,7 +27834,7 @@ func (p *CqlParser) DataTypeDefinition() (localctx IDataTypeDefinitionContext) {
 	}
 	{
 		p.SetState(1981)
-		p.DataTypeName()
+		p.DataType()
 	}
 	p.SetState(1987)
 	p.GetErrorHandler().Sync(p)
@@ -27509,7 +27847,7 @@ func (p *CqlParser) DataTypeDefinition() (localctx IDataTypeDefinitionContext) {
 		}
 		{
 			p.SetState(1983)
-			p.DataTypeName()
+			p.DataType()
 		}
 
 		p.SetState(1989)
@@ -38515,3 +38853,94 @@ func (p *CqlParser) SyntaxColon() (localctx ISyntaxColonContext) {
 
 	return localctx
 }
+
+// IKwStaticContext is an interface to support dynamic dispatch.
+type IKwStaticContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsKwStaticContext differentiates from other interfaces.
+	IsKwStaticContext()
+}
+
+type KwStaticContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyKwStaticContext() *KwStaticContext {
+	var p = new(KwStaticContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = CqlParserRULE_kwStatic
+	return p
+}
+
+func (*KwStaticContext) IsKwStaticContext() {}
+
+func NewKwStaticContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *KwStaticContext {
+	var p = new(KwStaticContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = CqlParserRULE_kwStatic
+
+	return p
+}
+
+func (s *KwStaticContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *KwStaticContext) K_STATIC() antlr.TerminalNode {
+	return s.GetToken(CqlParserK_STATIC, 0)
+}
+
+func (s *KwStaticContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *KwStaticContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *KwStaticContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(CqlParserListener); ok {
+		listenerT.EnterKwStatic(s)
+	}
+}
+
+func (s *KwStaticContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(CqlParserListener); ok {
+		listenerT.ExitKwStatic(s)
+	}
+}
+
+func (p *CqlParser) KwStatic() (localctx IKwStaticContext) {
+	localctx = NewKwStaticContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 2252, CqlParserRULE_kwStatic)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(2254)
+		p.Match(CqlParserK_STATIC)
+	}
+
+	return localctx
+}
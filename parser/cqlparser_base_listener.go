@@ -1694,3 +1694,9 @@ func (s *BaseCqlParserListener) EnterSyntaxColon(ctx *SyntaxColonContext) {}
 
 // ExitSyntaxColon is called when production syntaxColon is exited.
 func (s *BaseCqlParserListener) ExitSyntaxColon(ctx *SyntaxColonContext) {}
+
+// EnterKwStatic is called when production kwStatic is entered.
+func (s *BaseCqlParserListener) EnterKwStatic(ctx *KwStaticContext) {}
+
+// ExitKwStatic is called when production kwStatic is exited.
+func (s *BaseCqlParserListener) ExitKwStatic(ctx *KwStaticContext) {}
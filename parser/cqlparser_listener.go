@@ -842,6 +842,9 @@ type CqlParserListener interface {
 	// EnterSyntaxColon is called when entering the syntaxColon production.
 	EnterSyntaxColon(c *SyntaxColonContext)
 
+	// EnterKwStatic is called when entering the kwStatic production.
+	EnterKwStatic(c *KwStaticContext)
+
 	// ExitRoot is called when exiting the root production.
 	ExitRoot(c *RootContext)
 
@@ -1675,4 +1678,7 @@ type CqlParserListener interface {
 
 	// ExitSyntaxColon is called when exiting the syntaxColon production.
 	ExitSyntaxColon(c *SyntaxColonContext)
+
+	// ExitKwStatic is called when exiting the kwStatic production.
+	ExitKwStatic(c *KwStaticContext)
 }
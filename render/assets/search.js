@@ -0,0 +1,46 @@
+// Client-side search over names and comments of schema elements.
+// The index is loaded by search-index.js into cqldocSearchIndex.
+(function () {
+	var input = document.getElementById("search");
+	var results = document.getElementById("search-results");
+	if (!input || !results || typeof cqldocSearchIndex === "undefined") {
+		return;
+	}
+
+	function render(query) {
+		results.textContent = "";
+		query = query.trim().toLowerCase();
+		if (query === "") {
+			return;
+		}
+		var count = 0;
+		for (var i = 0; i < cqldocSearchIndex.length && count < 50; i++) {
+			var entry = cqldocSearchIndex[i];
+			if (entry.name.toLowerCase().indexOf(query) < 0 && entry.comment.toLowerCase().indexOf(query) < 0) {
+				continue;
+			}
+			var link = document.createElement("a");
+			link.href = entry.url;
+			link.textContent = entry.name;
+			var kind = document.createElement("small");
+			kind.textContent = " " + entry.kind;
+			var item = document.createElement("li");
+			item.appendChild(link);
+			item.appendChild(kind);
+			if (entry.comment) {
+				item.title = entry.comment;
+			}
+			results.appendChild(item);
+			count++;
+		}
+		if (count === 0) {
+			var none = document.createElement("li");
+			none.textContent = "No results";
+			results.appendChild(none);
+		}
+	}
+
+	input.addEventListener("input", function () {
+		render(input.value);
+	});
+})();
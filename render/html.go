@@ -0,0 +1,240 @@
+package render
+
+import (
+	"encoding/json"
+	"github.com/martin-sucha/cqldoc/schema"
+	"html/template"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// HTMLOptions configure the generated site.
+type HTMLOptions struct {
+	// SourceURL is the template of links to the source of schema elements.
+	// {file} and {line} are replaced by the position of the element,
+	// for example https://git.example.com/repo/blob/main/{file}#L{line}.
+	// Positions are shown without links if empty.
+	SourceURL string
+}
+
+var htmlTemplates = template.Must(template.New("").
+	Funcs(template.FuncMap(funcs)).
+	Funcs(template.FuncMap{
+		"page":       page,
+		"typeHTML":   func(*schema.DataType) template.HTML { return "" },
+		"links":      htmlLinks,
+		"sourceLink": func(schema.Position) string { return "" },
+	}).
+	ParseFS(templates, "templates/html/*.tmpl"))
+
+// htmlPage is the data of a single page of the site.
+type htmlPage struct {
+	Title    string
+	Schema   *schema.Schema
+	Keyspace *schema.Keyspace
+	Table    *schema.Table
+	View     *schema.MaterializedView
+	Type     *schema.Type
+}
+
+// searchEntry is an item of the search index.
+type searchEntry struct {
+	Kind    string `json:"kind"`
+	Name    string `json:"name"`
+	Comment string `json:"comment"`
+	URL     string `json:"url"`
+}
+
+// HTML writes the documentation of the schema as a static site to the directory.
+// The site has an index of keyspaces and a page for each table, materialized view and user-defined type,
+// with an anchor for each column and field.
+// All assets, including the client-side search, are written to the directory as well.
+func HTML(dir string, s *schema.Schema, opts HTMLOptions) error {
+	t, err := htmlTemplates.Clone()
+	if err != nil {
+		return err
+	}
+	t.Funcs(template.FuncMap{
+		"sourceLink": func(position schema.Position) string {
+			return sourceLink(opts.SourceURL, position)
+		},
+		"typeHTML": func(dataType *schema.DataType) template.HTML {
+			return typeHTML(s, dataType)
+		},
+	})
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	var index []searchEntry
+	write := func(name, templateName string, data htmlPage) error {
+		f, err := os.Create(filepath.Join(dir, name))
+		if err != nil {
+			return err
+		}
+		err = t.ExecuteTemplate(f, templateName, data)
+		if closeErr := f.Close(); err == nil {
+			err = closeErr
+		}
+		return err
+	}
+
+	if err := write("index.html", "index.html.tmpl", htmlPage{Title: "Schema", Schema: s}); err != nil {
+		return err
+	}
+	for _, keyspace := range s.Keyspaces {
+		for _, table := range keyspace.Tables {
+			url := page("table", table.Keyspace, table.Name)
+			index = append(index, searchEntry{"table", qualified(table.Keyspace, table.Name), firstLine(plainLinks(commentText(table.Comment, table.Doc), table.Doc)), url})
+			for _, column := range table.Columns {
+				index = append(index, searchEntry{
+					Kind:    "column",
+					Name:    qualified(table.Keyspace, table.Name) + "." + column.Name,
+					Comment: firstLine(plainLinks(commentText(column.Comment, column.Doc), column.Doc)),
+					URL:     url + "#" + anchor("column", column.Name),
+				})
+			}
+			data := htmlPage{Title: qualified(table.Keyspace, table.Name), Schema: s, Keyspace: keyspace, Table: table}
+			if err := write(url, "table.html.tmpl", data); err != nil {
+				return err
+			}
+		}
+		for _, view := range keyspace.Views {
+			url := page("view", view.Keyspace, view.Name)
+			index = append(index, searchEntry{"view", qualified(view.Keyspace, view.Name), firstLine(plainLinks(commentText(view.Comment, view.Doc), view.Doc)), url})
+			data := htmlPage{Title: qualified(view.Keyspace, view.Name), Schema: s, Keyspace: keyspace, View: view}
+			if err := write(url, "view.html.tmpl", data); err != nil {
+				return err
+			}
+		}
+		for _, udt := range keyspace.Types {
+			url := page("type", udt.Keyspace, udt.Name)
+			index = append(index, searchEntry{"type", qualified(udt.Keyspace, udt.Name), firstLine(plainLinks(commentText(udt.Comment, udt.Doc), udt.Doc)), url})
+			for _, field := range udt.Fields {
+				index = append(index, searchEntry{
+					Kind:    "field",
+					Name:    qualified(udt.Keyspace, udt.Name) + "." + field.Name,
+					Comment: firstLine(plainLinks(commentText(field.Comment, field.Doc), field.Doc)),
+					URL:     url + "#" + anchor("field", field.Name),
+				})
+			}
+			data := htmlPage{Title: qualified(udt.Keyspace, udt.Name), Schema: s, Keyspace: keyspace, Type: udt}
+			if err := write(url, "type.html.tmpl", data); err != nil {
+				return err
+			}
+		}
+	}
+
+	indexJSON, err := json.Marshal(index)
+	if err != nil {
+		return err
+	}
+	searchIndex := "var cqldocSearchIndex = " + string(indexJSON) + ";\n"
+	if err := os.WriteFile(filepath.Join(dir, "search-index.js"), []byte(searchIndex), 0644); err != nil {
+		return err
+	}
+	return copyAssets(dir)
+}
+
+// copyAssets writes the static assets of the site to the directory.
+func copyAssets(dir string) error {
+	return fs.WalkDir(assets, "assets", func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		data, err := assets.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		return os.WriteFile(filepath.Join(dir, d.Name()), data, 0644)
+	})
+}
+
+// page returns the file name of the page of a table, materialized view or user-defined type.
+func page(kind, keyspace, name string) string {
+	return anchor(kind, keyspace, name) + ".html"
+}
+
+// htmlLinks escapes the text and replaces [[target]] links in it with links to the pages of the resolved elements.
+// Broken links are replaced with the plain target.
+func htmlLinks(text string, doc *schema.Doc) template.HTML {
+	return template.HTML(doc.ReplaceLinks(template.HTMLEscapeString(text), func(target string, link *schema.Link) string {
+		ref := resolvedLink(link)
+		if ref == nil {
+			return target
+		}
+		url := "index.html#" + referenceAnchor(ref, false)
+		if ref.Kind != schema.ReferenceKindKeyspace {
+			url = page(ref.Kind.String(), ref.Keyspace, ref.Object)
+			if fragment := referenceAnchor(ref, true); fragment != "" {
+				url += "#" + fragment
+			}
+		}
+		return `<a href="` + template.HTMLEscapeString(url) + `">` + target + "</a>"
+	}))
+}
+
+// typeHTML returns the data type with names of user-defined types linked to their pages.
+// Types that do not exist in the schema are not linked.
+func typeHTML(s *schema.Schema, dataType *schema.DataType) template.HTML {
+	var b strings.Builder
+	writeTypeHTML(&b, s, dataType)
+	return template.HTML(b.String())
+}
+
+func writeTypeHTML(b *strings.Builder, s *schema.Schema, dataType *schema.DataType) {
+	if dataType.Frozen {
+		b.WriteString("frozen&lt;")
+	}
+	switch dataType.Kind {
+	case schema.DataTypeList, schema.DataTypeSet:
+		b.WriteString(dataType.Kind.String() + "&lt;")
+		writeTypeHTML(b, s, dataType.Element)
+		b.WriteString("&gt;")
+	case schema.DataTypeMap:
+		b.WriteString("map&lt;")
+		writeTypeHTML(b, s, dataType.Key)
+		b.WriteString(", ")
+		writeTypeHTML(b, s, dataType.Value)
+		b.WriteString("&gt;")
+	case schema.DataTypeTuple:
+		b.WriteString("tuple&lt;")
+		for i, member := range dataType.Members {
+			if i > 0 {
+				b.WriteString(", ")
+			}
+			writeTypeHTML(b, s, member)
+		}
+		b.WriteString("&gt;")
+	case schema.DataTypeUserDefined:
+		udt := *dataType
+		udt.Frozen = false
+		if s.GetType(dataType.Keyspace, dataType.Name) == nil {
+			b.WriteString(template.HTMLEscapeString(udt.String()))
+			break
+		}
+		b.WriteString(`<a href="` + template.HTMLEscapeString(page("type", dataType.Keyspace, dataType.Name)) + `">`)
+		b.WriteString(template.HTMLEscapeString(udt.String()))
+		b.WriteString("</a>")
+	default:
+		b.WriteString(template.HTMLEscapeString(dataType.Name))
+	}
+	if dataType.Frozen {
+		b.WriteString("&gt;")
+	}
+}
+
+// sourceLink returns the link to the position built from the sourceURL template.
+// It returns an empty string if the template or the position is not set.
+func sourceLink(sourceURL string, position schema.Position) string {
+	if sourceURL == "" || !position.IsValid() {
+		return ""
+	}
+	return strings.NewReplacer(
+		"{file}", filepath.ToSlash(position.File),
+		"{line}", strconv.Itoa(position.Line),
+	).Replace(sourceURL)
+}
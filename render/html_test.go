@@ -0,0 +1,131 @@
+package render
+
+import (
+	"github.com/martin-sucha/cqldoc/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"html/template"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestHTML(t *testing.T) {
+	s, err := schema.ParseString(testSchema, schema.WithFileName("migrations/0001_init.cql"))
+	require.NoError(t, err)
+	dir := t.TempDir()
+	require.NoError(t, HTML(dir, s, HTMLOptions{SourceURL: "https://git.example.com/repo/blob/main/{file}#L{line}"}))
+
+	for _, name := range []string{
+		"index.html",
+		"table-ks-users.html",
+		"view-ks-users_by_name.html",
+		"type-ks-address.html",
+		"search-index.js",
+		"search.js",
+		"style.css",
+	} {
+		assert.FileExists(t, filepath.Join(dir, name))
+	}
+
+	index := readFile(t, filepath.Join(dir, "index.html"))
+	assert.Contains(t, index, `<section id="keyspace-ks">`)
+	assert.Contains(t, index, `<dt><a href="table-ks-users.html">users</a></dt>`)
+
+	table := readFile(t, filepath.Join(dir, "table-ks-users.html"))
+	assert.Contains(t, table, `<tr id="column-id">`)
+	assert.Contains(t, table, `<td><code>frozen&lt;<a href="type-ks-address.html">address</a>&gt;</code></td>`)
+	assert.Contains(t, table, `<a href="https://git.example.com/repo/blob/main/migrations/0001_init.cql#L12">`)
+	assert.Contains(t, table, `<a href="view-ks-users_by_name.html">ks.users_by_name</a>`)
+	assert.Contains(t, table, `<td class="comment">Find users by name.</td>`)
+
+	view := readFile(t, filepath.Join(dir, "view-ks-users_by_name.html"))
+	assert.Contains(t, view, `Base table: <a href="table-ks-users.html">ks.users</a>`)
+
+	udt := readFile(t, filepath.Join(dir, "type-ks-address.html"))
+	assert.Contains(t, udt, `<tr id="field-city">`)
+	assert.Contains(t, udt, `<a href="table-ks-users.html#column-addr">ks.users.addr</a>`)
+
+	searchIndex := readFile(t, filepath.Join(dir, "search-index.js"))
+	assert.Contains(t, searchIndex,
+		`{"kind":"column","name":"ks.users.id","comment":"User identifier.","url":"table-ks-users.html#column-id"}`)
+}
+
+func TestHTMLWithoutSourceURL(t *testing.T) {
+	s, err := schema.ParseString(testSchema)
+	require.NoError(t, err)
+	dir := t.TempDir()
+	require.NoError(t, HTML(dir, s, HTMLOptions{}))
+	table := readFile(t, filepath.Join(dir, "table-ks-users.html"))
+	assert.Contains(t, table, `<p class="source">Defined at 12:1</p>`)
+}
+
+func TestTypeHTML(t *testing.T) {
+	s, err := schema.ParseString(`CREATE TYPE ks.address (city text);`)
+	require.NoError(t, err)
+	dataType := &schema.DataType{
+		Kind: schema.DataTypeMap,
+		Key:  &schema.DataType{Kind: schema.DataTypeNative, Name: "text"},
+		Value: &schema.DataType{
+			Kind:     schema.DataTypeUserDefined,
+			Keyspace: "ks",
+			Name:     "address",
+			Frozen:   true,
+		},
+	}
+	assert.Equal(t,
+		template.HTML(`map&lt;text, frozen&lt;<a href="type-ks-address.html">address</a>&gt;&gt;`),
+		typeHTML(s, dataType))
+
+	missing := &schema.DataType{Kind: schema.DataTypeUserDefined, Keyspace: "ks", Name: "nope", Frozen: true}
+	assert.Equal(t, template.HTML(`frozen&lt;nope&gt;`), typeHTML(s, missing))
+}
+
+func readFile(t *testing.T, name string) string {
+	data, err := os.ReadFile(name)
+	require.NoError(t, err)
+	return string(data)
+}
+
+func TestHTMLDocComments(t *testing.T) {
+	s, err := schema.ParseString(`-- Users of the application.
+-- @deprecated
+CREATE TABLE ks.users (
+	-- Email address.
+	-- @pii
+	email text PRIMARY KEY
+);`, schema.WithDocComments())
+	require.NoError(t, err)
+	dir := t.TempDir()
+	require.NoError(t, HTML(dir, s, HTMLOptions{}))
+
+	page := readFile(t, filepath.Join(dir, "table-ks-users.html"))
+	assert.Contains(t, page, `<span class="badge">deprecated</span>`)
+	assert.Contains(t, page, `Email address. <span class="badge">PII</span></td>`)
+	assert.NotContains(t, page, "@pii")
+}
+
+func TestHTMLLinks(t *testing.T) {
+	s, err := schema.ParseString(`CREATE TABLE ks.users (
+	-- Referenced by [[sessions.user_id]] & [[ks]].
+	id uuid PRIMARY KEY
+);
+-- Sessions of [[users]].
+-- @see address.city
+-- @see https://example.com
+CREATE TABLE ks.sessions (user_id uuid PRIMARY KEY);
+CREATE TYPE ks.address (city text);`, schema.WithDocComments())
+	require.NoError(t, err)
+	s.ResolveLinks()
+	dir := t.TempDir()
+	require.NoError(t, HTML(dir, s, HTMLOptions{}))
+
+	users := readFile(t, filepath.Join(dir, "table-ks-users.html"))
+	assert.Contains(t, users,
+		`Referenced by <a href="table-ks-sessions.html#column-user_id">sessions.user_id</a> &amp; <a href="index.html#keyspace-ks">ks</a>.`)
+	sessions := readFile(t, filepath.Join(dir, "table-ks-sessions.html"))
+	assert.Contains(t, sessions, `<p class="comment">Sessions of <a href="table-ks-users.html">users</a>.</p>`)
+	assert.Contains(t, sessions,
+		`<p class="see">See also: <a href="type-ks-address.html#field-city">address.city</a>, https://example.com</p>`)
+	assert.Contains(t, readFile(t, filepath.Join(dir, "search-index.js")), `"comment":"Sessions of users."`)
+}
@@ -0,0 +1,52 @@
+package render
+
+import (
+	"github.com/martin-sucha/cqldoc/schema"
+	"io"
+	"strings"
+	"text/template"
+)
+
+var markdownTemplate = template.Must(template.New("markdown.md.tmpl").
+	Funcs(funcs).
+	Funcs(template.FuncMap{
+		"cell":         markdownCell,
+		"links":        markdownLinks,
+		"definedTypes": func(*schema.DataType) []*schema.DataType { return nil },
+	}).
+	ParseFS(templates, "templates/markdown.md.tmpl"))
+
+// Markdown writes the documentation of the schema as a single Markdown document.
+// The document has a section for each keyspace, table, materialized view and user-defined type,
+// with links between tables, their views and the types they use.
+func Markdown(w io.Writer, s *schema.Schema) error {
+	t, err := markdownTemplate.Clone()
+	if err != nil {
+		return err
+	}
+	t.Funcs(template.FuncMap{
+		"definedTypes": func(dataType *schema.DataType) []*schema.DataType {
+			return definedTypes(s, dataType)
+		},
+	})
+	return t.Execute(w, s)
+}
+
+var markdownCellReplacer = strings.NewReplacer("|", `\|`, "\r\n", "<br>", "\n", "<br>")
+
+// markdownCell escapes text so that it can be used in a cell of a Markdown table.
+func markdownCell(text string) string {
+	return markdownCellReplacer.Replace(strings.TrimSpace(text))
+}
+
+// markdownLinks replaces [[target]] links in the text with Markdown links to the resolved elements.
+// Broken links are replaced with the plain target.
+func markdownLinks(text string, doc *schema.Doc) string {
+	return doc.ReplaceLinks(text, func(target string, link *schema.Link) string {
+		ref := resolvedLink(link)
+		if ref == nil {
+			return target
+		}
+		return "[" + target + "](#" + referenceAnchor(ref, false) + ")"
+	})
+}
@@ -0,0 +1,126 @@
+package render
+
+import (
+	"bytes"
+	"github.com/martin-sucha/cqldoc/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+const testSchema = `-- Keyspace of the application.
+CREATE KEYSPACE ks WITH replication = {'class': 'SimpleStrategy', 'replication_factor': 1};
+
+-- Postal address.
+CREATE TYPE ks.address (
+	street text,
+	-- City | town.
+	city text
+);
+
+-- Users of the application.
+CREATE TABLE ks.users (
+	-- User identifier.
+	id uuid,
+	ts timestamp,
+	name text STATIC,
+	addr frozen<address>,
+	PRIMARY KEY (id, ts)
+) WITH CLUSTERING ORDER BY (ts DESC) AND compaction = {'class': 'LeveledCompactionStrategy'};
+
+-- Find users by name.
+CREATE INDEX ON ks.users (name);
+
+CREATE MATERIALIZED VIEW ks.users_by_name AS
+	SELECT * FROM ks.users
+	WHERE name IS NOT NULL AND id IS NOT NULL AND ts IS NOT NULL
+	PRIMARY KEY (name, id, ts);
+`
+
+func TestMarkdown(t *testing.T) {
+	s, err := schema.ParseString(testSchema)
+	require.NoError(t, err)
+	var buf bytes.Buffer
+	require.NoError(t, Markdown(&buf, s))
+	out := buf.String()
+
+	assert.Contains(t, out, "## <a name=\"keyspace-ks\"></a>Keyspace `ks`\n\nKeyspace of the application.\n")
+	assert.Contains(t, out, "Replication: `SimpleStrategy`, replication_factor: 1\n")
+	assert.Contains(t, out, "### <a name=\"table-ks-users\"></a>Table `ks.users`\n\nUsers of the application.\n")
+	assert.Contains(t, out, "| <a name=\"column-ks-users-id\"></a>`id` | `uuid` | partition key 1 | User identifier. |\n")
+	assert.Contains(t, out, "| <a name=\"column-ks-users-ts\"></a>`ts` | `timestamp` | clustering key 1 desc |  |\n")
+	assert.Contains(t, out, "| <a name=\"column-ks-users-name\"></a>`name` | `text` | static |  |\n")
+	assert.Contains(t, out,
+		"| <a name=\"column-ks-users-addr\"></a>`addr` | `frozen<address>` [address](#type-ks-address) |  |  |\n")
+	assert.Contains(t, out, "| `compaction` | {'class': 'LeveledCompactionStrategy'} |\n")
+	assert.Contains(t, out, "- `users_name_idx` on `name` (values): Find users by name.\n")
+	assert.Contains(t, out, "- [ks.users_by_name](#view-ks-users_by_name)\n")
+	assert.Contains(t, out, "Base table: [ks.users](#table-ks-users)\n")
+	assert.Contains(t, out, "| <a name=\"field-ks-address-city\"></a>`city` | `text` | City \\| town. |\n")
+	assert.Contains(t, out, "- [ks.users.addr](#column-ks-users-addr)\n")
+}
+
+func TestMarkdownDocComments(t *testing.T) {
+	s, err := schema.ParseString(`-- Users of the application.
+-- @since 1.4
+CREATE TABLE users (
+	-- Email address.
+	-- @pii
+	email text PRIMARY KEY
+);`, schema.WithDocComments())
+	require.NoError(t, err)
+	var buf bytes.Buffer
+	require.NoError(t, Markdown(&buf, s))
+	out := buf.String()
+
+	assert.Contains(t, out, "\n\n**since 1.4**\n\nUsers of the application.\n")
+	assert.Contains(t, out, "| partition key 1 | Email address. **PII** |\n")
+	assert.NotContains(t, out, "@")
+}
+
+func TestMarkdownLinks(t *testing.T) {
+	s, err := schema.ParseString(`CREATE TABLE ks.users (
+	-- Primary key, referenced by [[sessions.user_id]].
+	id uuid PRIMARY KEY,
+	-- See [[missing]].
+	-- @see ks
+	name text
+);
+CREATE TABLE ks.sessions (user_id uuid PRIMARY KEY);
+-- Postal address of [[users]].
+CREATE TYPE ks.address (city text);`, schema.WithDocComments())
+	require.NoError(t, err)
+	s.ResolveLinks()
+	var buf bytes.Buffer
+	require.NoError(t, Markdown(&buf, s))
+	out := buf.String()
+
+	assert.Contains(t, out, "| Primary key, referenced by [sessions.user_id](#column-ks-sessions-user_id). |\n")
+	assert.Contains(t, out, "| See missing. See also: [ks](#keyspace-ks) |\n")
+	assert.Contains(t, out, "\n\nPostal address of [users](#table-ks-users).\n")
+}
+
+func TestMarkdownMissingType(t *testing.T) {
+	s, err := schema.ParseString(`CREATE TABLE ks.tbl (a int PRIMARY KEY, b frozen<nope>);`)
+	require.NoError(t, err)
+	var buf bytes.Buffer
+	require.NoError(t, Markdown(&buf, s))
+	out := buf.String()
+
+	assert.Contains(t, out, "| <a name=\"column-ks-tbl-b\"></a>`b` | `frozen<nope>` |  |  |\n")
+	assert.NotContains(t, out, "#type-ks-nope")
+}
+
+func TestMarkdownNoKeyspace(t *testing.T) {
+	s, err := schema.ParseString(`CREATE TABLE tbl (a int PRIMARY KEY);`)
+	require.NoError(t, err)
+	var buf bytes.Buffer
+	require.NoError(t, Markdown(&buf, s))
+	out := buf.String()
+
+	assert.Contains(t, out, "\n## No keyspace\n")
+	assert.Contains(t, out, "### <a name=\"table-tbl\"></a>Table `tbl`\n")
+	assert.Contains(t, out, "| <a name=\"column-tbl-a\"></a>`a` |")
+	assert.NotContains(t, out, "Keyspace ``")
+	assert.NotContains(t, out, "keyspace-\"")
+}
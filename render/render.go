@@ -0,0 +1,312 @@
+// Package render generates documentation from a parsed schema.
+package render
+
+import (
+	"embed"
+	"fmt"
+	"github.com/martin-sucha/cqldoc/schema"
+	"regexp"
+	"sort"
+	"strings"
+	"text/template"
+)
+
+// templates are the templates of the built-in formats.
+//
+//go:embed templates
+var templates embed.FS
+
+// assets are the static files of the HTML site.
+//
+//go:embed assets
+var assets embed.FS
+
+// funcs are the helper functions available in templates, see NewTemplate.
+var funcs = template.FuncMap{
+	"anchor":      anchor,
+	"keyRole":     keyRole,
+	"firstLine":   firstLine,
+	"commentText": commentText,
+	"badges":      badges,
+	"see":         see,
+	"plainLinks":  plainLinks,
+	"lines":       lines,
+	"replace":     strings.ReplaceAll,
+	"cqlType":     cqlType,
+	"userTypes":   userTypes,
+	"options":     options,
+	"typeUsages":  typeUsages,
+	"qualified":   qualified,
+}
+
+var nonAnchorRegexp = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// anchor returns an identifier of a schema element usable in links, for example table-ks-users.
+// Parts are lowercased and characters that are not allowed in the identifier are replaced by a dash.
+// Empty parts, for example the keyspace of objects created without a keyspace, are skipped.
+func anchor(parts ...string) string {
+	var cleaned []string
+	for _, part := range parts {
+		if part == "" {
+			continue
+		}
+		cleaned = append(cleaned, strings.Trim(nonAnchorRegexp.ReplaceAllString(strings.ToLower(part), "-"), "-"))
+	}
+	return strings.Join(cleaned, "-")
+}
+
+// keyRole describes the role of the column in the primary key, for example "clustering key 1 desc".
+// It returns "static" for static columns and an empty string for other regular columns.
+func keyRole(column *schema.Column) string {
+	switch column.Kind {
+	case schema.ColumnKindPartitionKey:
+		return fmt.Sprintf("partition key %d", column.KeyIndex+1)
+	case schema.ColumnKindClustering:
+		return fmt.Sprintf("clustering key %d %s", column.KeyIndex+1, column.ClusteringOrder)
+	}
+	if column.Static {
+		return "static"
+	}
+	return ""
+}
+
+// firstLine returns the first line of a comment.
+func firstLine(comment string) string {
+	comment = strings.TrimSpace(comment)
+	if idx := strings.IndexByte(comment, '\n'); idx >= 0 {
+		return strings.TrimSpace(comment[:idx])
+	}
+	return comment
+}
+
+// commentText returns the comment without doc tags if the comment was parsed into doc.
+func commentText(comment string, doc *schema.Doc) string {
+	if doc == nil {
+		return comment
+	}
+	return doc.Text
+}
+
+// badges returns short labels of the recognized doc tags, for example "since 1.4".
+func badges(doc *schema.Doc) []string {
+	if doc == nil {
+		return nil
+	}
+	var ret []string
+	if doc.Deprecated {
+		if doc.DeprecationNote != "" {
+			ret = append(ret, "deprecated: "+doc.DeprecationNote)
+		} else {
+			ret = append(ret, "deprecated")
+		}
+	}
+	if doc.PII {
+		ret = append(ret, "PII")
+	}
+	if doc.Since != "" {
+		ret = append(ret, "since "+doc.Since)
+	}
+	if doc.Owner != "" {
+		ret = append(ret, "owner "+doc.Owner)
+	}
+	return ret
+}
+
+// see returns the values of the @see tags separated by commas.
+// Values that are links to schema elements are written as [[target]], so that they can be rendered like links in the text.
+func see(doc *schema.Doc) string {
+	if doc == nil {
+		return ""
+	}
+	items := make([]string, len(doc.See))
+	for i, value := range doc.See {
+		if doc.GetLink(value) != nil {
+			value = "[[" + value + "]]"
+		}
+		items[i] = value
+	}
+	return strings.Join(items, ", ")
+}
+
+// plainLinks replaces [[target]] links in the text with the plain target.
+func plainLinks(text string, doc *schema.Doc) string {
+	return doc.ReplaceLinks(text, func(target string, _ *schema.Link) string {
+		return target
+	})
+}
+
+// resolvedLink returns the referenced element of the link, or nil if the link is broken.
+func resolvedLink(link *schema.Link) *schema.Reference {
+	if link == nil {
+		return nil
+	}
+	return link.Reference
+}
+
+// referenceAnchor returns the anchor of the referenced element in the Markdown document,
+// or the anchor of the column or field within the page of the object if page is true.
+func referenceAnchor(ref *schema.Reference, page bool) string {
+	switch {
+	case ref.Kind == schema.ReferenceKindKeyspace:
+		return anchor("keyspace", ref.Keyspace)
+	case ref.Column == "" && page:
+		return ""
+	case ref.Column == "":
+		return anchor(ref.Kind.String(), ref.Keyspace, ref.Object)
+	}
+	kind := "column"
+	if ref.Kind == schema.ReferenceKindType {
+		kind = "field"
+	}
+	if page {
+		return anchor(kind, ref.Column)
+	}
+	return anchor(kind, ref.Keyspace, ref.Object, ref.Column)
+}
+
+// lines splits a comment into lines.
+func lines(comment string) []string {
+	comment = strings.TrimSpace(comment)
+	if comment == "" {
+		return nil
+	}
+	return strings.Split(comment, "\n")
+}
+
+// cqlType returns the data type in the canonical CQL form.
+func cqlType(dataType *schema.DataType) string {
+	if dataType == nil {
+		return ""
+	}
+	return dataType.String()
+}
+
+// userTypes returns the user-defined types referenced by the data type, in the order of appearance.
+func userTypes(dataType *schema.DataType) []*schema.DataType {
+	if dataType == nil {
+		return nil
+	}
+	var ret []*schema.DataType
+	switch dataType.Kind {
+	case schema.DataTypeUserDefined:
+		ret = append(ret, dataType)
+	case schema.DataTypeList, schema.DataTypeSet:
+		ret = append(ret, userTypes(dataType.Element)...)
+	case schema.DataTypeMap:
+		ret = append(ret, userTypes(dataType.Key)...)
+		ret = append(ret, userTypes(dataType.Value)...)
+	case schema.DataTypeTuple:
+		for _, member := range dataType.Members {
+			ret = append(ret, userTypes(member)...)
+		}
+	}
+	return ret
+}
+
+// definedTypes returns the user-defined types referenced by the data type that exist in the schema.
+func definedTypes(s *schema.Schema, dataType *schema.DataType) []*schema.DataType {
+	var ret []*schema.DataType
+	for _, udt := range userTypes(dataType) {
+		if s.GetType(udt.Keyspace, udt.Name) != nil {
+			ret = append(ret, udt)
+		}
+	}
+	return ret
+}
+
+// option is a single option with its value formatted as in CQL.
+type option struct {
+	Name  string
+	Value string
+}
+
+// options returns the options sorted by name.
+// Map values are formatted like CQL map literals.
+func options(o schema.Options) []option {
+	var ret []option
+	for name, value := range o.Values {
+		ret = append(ret, option{Name: name, Value: value})
+	}
+	for name, values := range o.Maps {
+		ret = append(ret, option{Name: name, Value: formatMap(values)})
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].Name < ret[j].Name
+	})
+	return ret
+}
+
+// formatMap formats the map like a CQL map literal with keys sorted.
+func formatMap(values map[string]string) string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	items := make([]string, len(keys))
+	for i, key := range keys {
+		items[i] = fmt.Sprintf("'%s': '%s'", key, values[key])
+	}
+	return "{" + strings.Join(items, ", ") + "}"
+}
+
+// typeUsage is a column or a field that uses a user-defined type.
+type typeUsage struct {
+	// Kind is column for table columns or field for fields of other types.
+	Kind string
+	// Object is table or type.
+	Object   string
+	Keyspace string
+	Name     string
+	Column   string
+}
+
+// typeUsages returns the columns and fields of the schema that use the user-defined type.
+func typeUsages(s *schema.Schema, t *schema.Type) []typeUsage {
+	uses := func(dataType *schema.DataType) bool {
+		for _, udt := range userTypes(dataType) {
+			if udt.Keyspace == t.Keyspace && udt.Name == t.Name {
+				return true
+			}
+		}
+		return false
+	}
+	var ret []typeUsage
+	for _, keyspace := range s.Keyspaces {
+		for _, table := range keyspace.Tables {
+			for _, column := range table.Columns {
+				if uses(column.DataType) {
+					ret = append(ret, typeUsage{
+						Kind:     "column",
+						Object:   "table",
+						Keyspace: table.Keyspace,
+						Name:     table.Name,
+						Column:   column.Name,
+					})
+				}
+			}
+		}
+		for _, other := range keyspace.Types {
+			for _, field := range other.Fields {
+				if uses(field.DataType) {
+					ret = append(ret, typeUsage{
+						Kind:     "field",
+						Object:   "type",
+						Keyspace: other.Keyspace,
+						Name:     other.Name,
+						Column:   field.Name,
+					})
+				}
+			}
+		}
+	}
+	return ret
+}
+
+// qualified joins the keyspace and the name with a dot.
+func qualified(keyspace, name string) string {
+	if keyspace == "" {
+		return name
+	}
+	return keyspace + "." + name
+}
@@ -0,0 +1,57 @@
+package render
+
+import (
+	"github.com/martin-sucha/cqldoc/schema"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestAnchor(t *testing.T) {
+	assert.Equal(t, "table-ks-users", anchor("table", "ks", "users"))
+	assert.Equal(t, "column-ks-my-table-col_1", anchor("column", "ks", "My Table", "col_1"))
+	assert.Equal(t, "table-users", anchor("table", "", "users"))
+	assert.Equal(t, "keyspace", anchor("keyspace", ""))
+}
+
+func TestKeyRole(t *testing.T) {
+	assert.Equal(t, "partition key 2", keyRole(&schema.Column{Kind: schema.ColumnKindPartitionKey, KeyIndex: 1}))
+	assert.Equal(t, "clustering key 1 asc", keyRole(&schema.Column{
+		Kind:            schema.ColumnKindClustering,
+		ClusteringOrder: schema.ClusteringOrderAsc,
+	}))
+	assert.Equal(t, "static", keyRole(&schema.Column{Static: true}))
+	assert.Equal(t, "", keyRole(&schema.Column{}))
+}
+
+func TestFirstLine(t *testing.T) {
+	assert.Equal(t, "First line.", firstLine("\nFirst line.\nSecond line."))
+	assert.Equal(t, "Only line.", firstLine("Only line."))
+}
+
+func TestCommentText(t *testing.T) {
+	assert.Equal(t, "Raw @pii", commentText("Raw @pii", nil))
+	doc := schema.ParseDoc("Users. Stored forever.\n\nMore text.\n@pii")
+	assert.Equal(t, "Users. Stored forever.\n\nMore text.", commentText("ignored", doc))
+}
+
+func TestBadges(t *testing.T) {
+	assert.Empty(t, badges(nil))
+	doc := schema.ParseDoc("Users.\n@deprecated use accounts\n@since 1.4\n@owner team-x\n@pii")
+	assert.Equal(t, []string{"deprecated: use accounts", "PII", "since 1.4", "owner team-x"}, badges(doc))
+}
+
+func TestSee(t *testing.T) {
+	assert.Equal(t, "", see(nil))
+	assert.Equal(t, "[[ks.users]], https://example.com", see(schema.ParseDoc("@see ks.users\n@see https://example.com")))
+}
+
+func TestReferenceAnchor(t *testing.T) {
+	column := &schema.Reference{Kind: schema.ReferenceKindView, Keyspace: "ks", Object: "users_by_name", Column: "id"}
+	assert.Equal(t, "column-ks-users_by_name-id", referenceAnchor(column, false))
+	assert.Equal(t, "column-id", referenceAnchor(column, true))
+	field := &schema.Reference{Kind: schema.ReferenceKindType, Keyspace: "ks", Object: "address", Column: "city"}
+	assert.Equal(t, "field-ks-address-city", referenceAnchor(field, false))
+	table := &schema.Reference{Kind: schema.ReferenceKindTable, Keyspace: "ks", Object: "users"}
+	assert.Equal(t, "table-ks-users", referenceAnchor(table, false))
+	assert.Equal(t, "", referenceAnchor(table, true))
+}
@@ -0,0 +1,28 @@
+package render
+
+import (
+	"text/template"
+)
+
+// NewTemplate returns a new template with helper functions for rendering a schema.
+// The template is meant to be executed with *schema.Schema as its data.
+//
+// The helper functions are:
+//
+//	anchor part...          identifier usable in links, for example anchor "table" .Keyspace .Name
+//	keyRole column          role in the primary key, for example "clustering key 1 desc", or "static"
+//	firstLine comment       first line of a comment
+//	commentText comment doc comment without doc tags, if doc is set
+//	badges doc              labels of recognized doc tags, for example "since 1.4"
+//	see doc                 values of @see tags, with links to schema elements written as [[target]]
+//	plainLinks text doc     text with [[target]] links replaced by the plain target
+//	lines comment           lines of a comment
+//	replace s old new       s with all occurrences of old replaced by new
+//	cqlType dataType        data type in the canonical CQL form
+//	userTypes dataType      user-defined types referenced by a data type
+//	options options         options sorted by name, with Name and Value
+//	typeUsages schema type  columns and fields using a user-defined type, with Kind, Object, Keyspace, Name and Column
+//	qualified keyspace name name qualified by the keyspace
+func NewTemplate(name string) *template.Template {
+	return template.New(name).Funcs(funcs)
+}
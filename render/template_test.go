@@ -0,0 +1,36 @@
+package render
+
+import (
+	"bytes"
+	"github.com/martin-sucha/cqldoc/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestNewTemplate(t *testing.T) {
+	s, err := schema.ParseString(testSchema)
+	require.NoError(t, err)
+	tmpl, err := NewTemplate("wiki").Parse(`{{range .Keyspaces}}{{range .Tables -}}
+h1. {{qualified .Keyspace .Name}} {anchor:{{anchor "table" .Keyspace .Name}}}
+{{firstLine .Comment}}
+{{range .Columns}}|{{.Name}}|{{cqlType .DataType}}|{{keyRole .}}|{{range lines .Comment}}{{replace . "|" "\\|"}}{{end}}|
+{{end}}{{end}}{{range .Types}}{{range typeUsages $ .}}{{.Kind}} {{qualified .Keyspace .Name}}.{{.Column}}
+{{end}}{{end}}{{end}}`)
+	require.NoError(t, err)
+	var buf bytes.Buffer
+	require.NoError(t, tmpl.Execute(&buf, s))
+	assert.Equal(t, `h1. ks.users {anchor:table-ks-users}
+Users of the application.
+|id|uuid|partition key 1|User identifier.|
+|ts|timestamp|clustering key 1 desc||
+|name|text|static||
+|addr|frozen<address>|||
+column ks.users.addr
+`, buf.String())
+}
+
+func TestLines(t *testing.T) {
+	assert.Equal(t, []string{"First line.", "Second line."}, lines("First line.\nSecond line.\n"))
+	assert.Nil(t, lines(""))
+}
@@ -11,6 +11,38 @@ import (
 
 var regexpLineComment = regexp.MustCompile(`^(?:--|#|//)([^\r\n]*)`)
 
+// CommentPolicy selects how the leading comment of a statement is combined with the comment option
+// of a table or a materialized view.
+type CommentPolicy int
+
+const (
+	// CommentPreferLeading uses the leading comment if it is not empty, the comment option otherwise.
+	CommentPreferLeading CommentPolicy = iota
+	// CommentPreferOption uses the comment option if it is set, the leading comment otherwise.
+	CommentPreferOption
+	// CommentConcatenate uses both the leading comment and the comment option, separated by an empty line.
+	CommentConcatenate
+)
+
+// mergeComment combines the leading comment with the comment option according to the policy.
+func mergeComment(policy CommentPolicy, leading string, options *Options) string {
+	option, _ := options.Comment()
+	switch {
+	case option == "" || option == leading:
+		return leading
+	case leading == "":
+		return option
+	}
+	switch policy {
+	case CommentPreferOption:
+		return option
+	case CommentConcatenate:
+		return leading + "\n\n" + option
+	default:
+		return leading
+	}
+}
+
 // trimStarLine removes [ \t]+*? in the first maxColumn characters
 func trimStarLine(line string, maxColumn int) string {
 	if len(line) < maxColumn {
@@ -22,8 +54,8 @@ func trimStarLine(line string, maxColumn int) string {
 			return line[idx:]
 		}
 		switch r {
-		case '\t':
-		case ' ':
+		case '\t', ' ':
+			// Like in token positions, a tab is a single column.
 			column += 1
 		case '*':
 			if column != maxColumn-1 {
@@ -84,12 +116,21 @@ func getComment(hiddenTokens []antlr.Token) string {
 	case parser.CqlParserCOMMENT_INPUT:
 		text := lastCommentToken.GetText()
 		column := lastCommentToken.GetColumn()
-		scanner := bufio.NewScanner(bytes.NewReader([]byte(text)[2:len(text)-2]))
+		// Javadoc-style comments start with /** instead of /*.
+		text = strings.TrimPrefix(text[2:len(text)-2], "*")
+		scanner := bufio.NewScanner(bytes.NewReader([]byte(text)))
 		for scanner.Scan() {
 			line := scanner.Text()
 			line = trimStarLine(line, column+2)
 			comment = append(comment, line)
 		}
+		// Lines with only the opening or the closing delimiter are not part of the comment.
+		if len(comment) > 1 && strings.TrimSpace(comment[len(comment)-1]) == "" {
+			comment = comment[:len(comment)-1]
+		}
+		if len(comment) > 1 && strings.TrimSpace(comment[0]) == "" {
+			comment = comment[1:]
+		}
 	}
 
 	return strings.Join(unindentBlock(comment), "\n")
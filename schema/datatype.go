@@ -0,0 +1,200 @@
+package schema
+
+import (
+	"fmt"
+	"github.com/martin-sucha/cqldoc/parser"
+	"regexp"
+	"strings"
+)
+
+// DataType is a CQL data type of a column or a field.
+type DataType struct {
+	Kind DataTypeKind
+	// Name is the lowercase name of a native type, for example int,
+	// or the lowercase name of a user-defined type.
+	// Aliases of native types are replaced by the canonical name, for example varchar by text.
+	// It is empty for collections and tuples.
+	Name string
+	// Keyspace is the keyspace of a user-defined type.
+	Keyspace string
+	// Frozen is true if the type is wrapped in frozen<...>.
+	Frozen bool
+	// Element is the element type of a list or a set.
+	Element *DataType
+	// Key is the key type of a map.
+	Key *DataType
+	// Value is the value type of a map.
+	Value *DataType
+	// Members are the member types of a tuple.
+	Members []*DataType
+	// qualified is true if the name of the user-defined type was written with the keyspace.
+	qualified bool
+}
+
+// DataTypeKind is the category of a data type.
+type DataTypeKind int
+
+const (
+	// DataTypeNative is a built-in type such as int or text.
+	DataTypeNative DataTypeKind = iota
+	// DataTypeList is a list<element>.
+	DataTypeList
+	// DataTypeSet is a set<element>.
+	DataTypeSet
+	// DataTypeMap is a map<key, value>.
+	DataTypeMap
+	// DataTypeTuple is a tuple<member, ...>.
+	DataTypeTuple
+	// DataTypeUserDefined is a reference to a user-defined type.
+	DataTypeUserDefined
+)
+
+func (k DataTypeKind) String() string {
+	switch k {
+	case DataTypeNative:
+		return "native"
+	case DataTypeList:
+		return "list"
+	case DataTypeSet:
+		return "set"
+	case DataTypeMap:
+		return "map"
+	case DataTypeTuple:
+		return "tuple"
+	case DataTypeUserDefined:
+		return "udt"
+	default:
+		return fmt.Sprintf("DataTypeKind(%d)", int(k))
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (k DataTypeKind) MarshalText() ([]byte, error) {
+	return []byte(k.String()), nil
+}
+
+// String returns the type in the canonical form used by Cassandra, for example map<text, frozen<list<int>>>.
+func (t *DataType) String() string {
+	var s string
+	switch t.Kind {
+	case DataTypeList, DataTypeSet:
+		s = t.Kind.String() + "<" + t.Element.String() + ">"
+	case DataTypeMap:
+		s = "map<" + t.Key.String() + ", " + t.Value.String() + ">"
+	case DataTypeTuple:
+		members := make([]string, len(t.Members))
+		for i, member := range t.Members {
+			members[i] = member.String()
+		}
+		s = "tuple<" + strings.Join(members, ", ") + ">"
+	case DataTypeUserDefined:
+		s = quoteIdentifier(t.Name)
+		if t.qualified {
+			s = t.Keyspace + "." + s
+		}
+	default:
+		s = t.Name
+	}
+	if t.Frozen {
+		s = "frozen<" + s + ">"
+	}
+	return s
+}
+
+var unquotedIdentifierRegexp = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+
+// quoteIdentifier returns the identifier in double quotes unless it can be written without them.
+func quoteIdentifier(name string) string {
+	if unquotedIdentifierRegexp.MatchString(name) {
+		return name
+	}
+	return `"` + strings.Replace(name, `"`, `""`, -1) + `"`
+}
+
+// nativeTypes are names of the built-in types.
+// Some of them are not keywords, so they are parsed like names of user-defined types.
+var nativeTypes = map[string]bool{
+	"ascii": true,
+	"bigint": true,
+	"blob": true,
+	"boolean": true,
+	"counter": true,
+	"date": true,
+	"decimal": true,
+	"double": true,
+	"duration": true,
+	"float": true,
+	"inet": true,
+	"int": true,
+	"smallint": true,
+	"text": true,
+	"time": true,
+	"timestamp": true,
+	"timeuuid": true,
+	"tinyint": true,
+	"uuid": true,
+	"varchar": true,
+	"varint": true,
+}
+
+// nativeTypeAliases are alternative names of native types, mapped to the canonical names used by Cassandra.
+var nativeTypeAliases = map[string]string{
+	"varchar": "text",
+}
+
+// parseDataType builds the type tree of ctx.
+// User-defined types without a keyspace in the name are resolved in the keyspace.
+func parseDataType(ctx *parser.DataTypeContext, keyspace string) *DataType {
+	name := ctx.DataTypeName().GetText()
+	var args []*DataType
+	if definition := ctx.DataTypeDefinition(); definition != nil {
+		for _, arg := range definition.(*parser.DataTypeDefinitionContext).AllDataType() {
+			args = append(args, parseDataType(arg.(*parser.DataTypeContext), keyspace))
+		}
+	}
+
+	checkArgs := func(n int) {
+		if len(args) != n {
+			panic(&SemanticError{Kind: ErrorKindInvalidType, Message: fmt.Sprintf("Type %s requires %d type arguments", name, n)})
+		}
+	}
+	if keyspaceCtx := ctx.Keyspace(); keyspaceCtx != nil {
+		// Only names of user-defined types can have a keyspace.
+		checkArgs(0)
+		return &DataType{Kind: DataTypeUserDefined, Name: strings.ToLower(name), Keyspace: keyspaceCtx.GetText(), qualified: true}
+	}
+	var ret *DataType
+	switch strings.ToLower(name) {
+	case "frozen":
+		checkArgs(1)
+		ret = args[0]
+		ret.Frozen = true
+	case "list":
+		checkArgs(1)
+		ret = &DataType{Kind: DataTypeList, Element: args[0]}
+	case "set":
+		checkArgs(1)
+		ret = &DataType{Kind: DataTypeSet, Element: args[0]}
+	case "map":
+		checkArgs(2)
+		ret = &DataType{Kind: DataTypeMap, Key: args[0], Value: args[1]}
+	case "tuple":
+		if len(args) == 0 {
+			panic(&SemanticError{Kind: ErrorKindInvalidType, Message: "Type tuple requires type arguments"})
+		}
+		ret = &DataType{Kind: DataTypeTuple, Members: args}
+	default:
+		checkArgs(0)
+		// Both native type names and unquoted names of user-defined types are case-insensitive.
+		name = strings.ToLower(name)
+		if nativeTypes[name] {
+			if alias, ok := nativeTypeAliases[name]; ok {
+				name = alias
+			}
+			ret = &DataType{Kind: DataTypeNative, Name: name}
+		} else {
+			ret = &DataType{Kind: DataTypeUserDefined, Name: name, Keyspace: keyspace}
+		}
+	}
+	return ret
+}
@@ -0,0 +1,135 @@
+package schema
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestNestedDataTypes(t *testing.T) {
+	schema, err := ParseString(`CREATE TABLE ab.tbl (
+	id Int PRIMARY KEY,
+	col1 map<text, frozen<list<int>>>,
+	col2 list<frozen<address>>,
+	col3 tuple<int, frozen<set<duration>>>
+);
+`)
+	require.NoError(t, err)
+	require.NotNil(t, schema)
+	table := schema.GetTable("ab", "tbl")
+	require.NotNil(t, table)
+
+	assert.Equal(t, &DataType{Kind: DataTypeNative, Name: "int"}, table.GetColumn("id").DataType)
+
+	col1 := table.GetColumn("col1")
+	require.NotNil(t, col1)
+	assert.Equal(t, "map<text, frozen<list<int>>>", col1.CqlType)
+	assert.Equal(t, &DataType{
+		Kind: DataTypeMap,
+		Key: &DataType{Kind: DataTypeNative, Name: "text"},
+		Value: &DataType{
+			Kind: DataTypeList,
+			Frozen: true,
+			Element: &DataType{Kind: DataTypeNative, Name: "int"},
+		},
+	}, col1.DataType)
+
+	assert.Equal(t, &DataType{
+		Kind: DataTypeList,
+		Element: &DataType{Kind: DataTypeUserDefined, Name: "address", Keyspace: "ab", Frozen: true},
+	}, table.GetColumn("col2").DataType)
+
+	assert.Equal(t, &DataType{
+		Kind: DataTypeTuple,
+		Members: []*DataType{
+			{Kind: DataTypeNative, Name: "int"},
+			{
+				Kind: DataTypeSet,
+				Frozen: true,
+				Element: &DataType{Kind: DataTypeNative, Name: "duration"},
+			},
+		},
+	}, table.GetColumn("col3").DataType)
+}
+
+func TestFieldDataType(t *testing.T) {
+	schema, err := ParseString(`CREATE TYPE ab.typ (col1 list<text>);
+ALTER TYPE ab.typ ADD col2 map<int, frozen<other>>;
+`)
+	require.NoError(t, err)
+	require.NotNil(t, schema)
+	typ := schema.GetType("ab", "typ")
+	require.NotNil(t, typ)
+	assert.Equal(t, &DataType{
+		Kind: DataTypeList,
+		Element: &DataType{Kind: DataTypeNative, Name: "text"},
+	}, typ.GetField("col1").DataType)
+	assert.Equal(t, &DataType{
+		Kind: DataTypeMap,
+		Key: &DataType{Kind: DataTypeNative, Name: "int"},
+		Value: &DataType{Kind: DataTypeUserDefined, Name: "other", Keyspace: "ab", Frozen: true},
+	}, typ.GetField("col2").DataType)
+}
+
+func TestQualifiedUserDefinedType(t *testing.T) {
+	schema, err := ParseString(`CREATE TYPE other.addr (city text);
+CREATE TABLE ab.tbl (
+	id int PRIMARY KEY,
+	col1 frozen<other.Addr>,
+	col2 list<frozen<ab.addr>>
+);
+`)
+	require.NoError(t, err)
+	require.NotNil(t, schema)
+	table := schema.GetTable("ab", "tbl")
+	require.NotNil(t, table)
+	assert.Equal(t, &DataType{Kind: DataTypeUserDefined, Name: "addr", Keyspace: "other", Frozen: true, qualified: true},
+		table.GetColumn("col1").DataType)
+	assert.Equal(t, "frozen<other.addr>", table.GetColumn("col1").CqlType)
+	assert.Equal(t, &DataType{
+		Kind: DataTypeList,
+		Element: &DataType{Kind: DataTypeUserDefined, Name: "addr", Keyspace: "ab", Frozen: true, qualified: true},
+	}, table.GetColumn("col2").DataType)
+	assert.Equal(t, "list<frozen<ab.addr>>", table.GetColumn("col2").CqlType)
+}
+
+func TestDataTypeWrongArguments(t *testing.T) {
+	for _, cqlType := range []string{"map<text>", "list<int, int>", "int<int>", "frozen<int, int>", "ab.addr<int>"} {
+		schema, err := ParseString(`CREATE TABLE ab.tbl (col1 ` + cqlType + `);`)
+		assert.Error(t, err, cqlType)
+		assert.Nil(t, schema, cqlType)
+	}
+}
+
+func TestCanonicalCqlType(t *testing.T) {
+	schema, err := ParseString(`CREATE TABLE ab.tbl (
+	col1 INT,
+	col2 MAP<Text,FROZEN<List<BigInt>>>,
+	col3 tuple<int,text,  frozen<MyType>>,
+	col4 set<frozen<my_type>>,
+	col5 VARCHAR,
+	col6 frozen<My$Type>
+);
+CREATE TYPE ab.typ (col1 LIST<TEXT>);
+CREATE TYPE ab.MyType (col1 int);
+`)
+	require.NoError(t, err)
+	require.NotNil(t, schema)
+	table := schema.GetTable("ab", "tbl")
+	require.NotNil(t, table)
+	assert.Equal(t, "int", table.GetColumn("col1").CqlType)
+	assert.Equal(t, "map<text, frozen<list<bigint>>>", table.GetColumn("col2").CqlType)
+	assert.Equal(t, "tuple<int, text, frozen<mytype>>", table.GetColumn("col3").CqlType)
+	assert.Equal(t, "set<frozen<my_type>>", table.GetColumn("col4").CqlType)
+	assert.Equal(t, "text", table.GetColumn("col5").CqlType)
+	assert.Equal(t, `frozen<"my$type">`, table.GetColumn("col6").CqlType)
+	assert.NotNil(t, schema.GetType("ab", "mytype"))
+	assert.Equal(t, "list<text>", schema.GetType("ab", "typ").GetField("col1").CqlType)
+}
+
+func TestQuoteIdentifier(t *testing.T) {
+	assert.Equal(t, "abc_1", quoteIdentifier("abc_1"))
+	assert.Equal(t, `"Abc"`, quoteIdentifier("Abc"))
+	assert.Equal(t, `"a$b"`, quoteIdentifier("a$b"))
+	assert.Equal(t, `"a""b"`, quoteIdentifier(`a"b`))
+}
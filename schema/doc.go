@@ -0,0 +1,151 @@
+package schema
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Doc is the parsed form of a documentation comment.
+//
+// The comment text is split into a summary and a body.
+// Lines starting with @ outside of fenced code blocks are tags, for example:
+//
+//	Users of the application.
+//
+//	Rows are never deleted.
+//	@since 1.4
+//	@owner team-accounts
+//	@see ks.accounts
+type Doc struct {
+	// Text is the comment without the tags.
+	Text string
+	// Summary is the first sentence of the first paragraph,
+	// or the whole paragraph if it has no sentence end.
+	Summary string
+	// Body is the text after the summary without the tags.
+	Body string
+	// Deprecated is true if the comment has a @deprecated tag.
+	Deprecated bool
+	// DeprecationNote is the text of the @deprecated tag, for example what to use instead.
+	DeprecationNote string
+	// Since is the version given by the @since tag.
+	Since string
+	// Owner is the owner given by the @owner tag.
+	Owner string
+	// PII is true if the comment has a @pii tag, meaning the element holds personally identifiable information.
+	PII bool
+	// See are the references given by @see tags.
+	See []string
+	// Links are the links to other schema elements, see Link.
+	Links []*Link
+	// Tags are all tags in the order of appearance, including the ones not recognized above.
+	Tags []DocTag
+}
+
+// DocTag is a single tag of a documentation comment, for example @since 1.4.
+type DocTag struct {
+	// Name is the lowercase name of the tag without the @ sign.
+	Name string
+	// Value is the text after the tag name.
+	// It continues on the following lines until an empty line or the next tag.
+	Value string
+}
+
+var docTagRegexp = regexp.MustCompile(`^@([A-Za-z][A-Za-z0-9_-]*)(?:\s+(.*))?$`)
+
+// sentenceEndRegexp matches the end of a sentence, a period followed by whitespace.
+var sentenceEndRegexp = regexp.MustCompile(`[.!?]\s`)
+
+// ParseDoc parses a documentation comment.
+func ParseDoc(comment string) *Doc {
+	doc := &Doc{}
+	var text []string
+	var tag *DocTag
+	inCode := false
+	for _, line := range strings.Split(comment, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~") {
+			inCode = !inCode
+		}
+		if !inCode {
+			if match := docTagRegexp.FindStringSubmatch(trimmed); match != nil {
+				doc.Tags = append(doc.Tags, DocTag{Name: strings.ToLower(match[1]), Value: strings.TrimSpace(match[2])})
+				tag = &doc.Tags[len(doc.Tags)-1]
+				continue
+			}
+			if tag != nil && trimmed != "" {
+				tag.Value = strings.TrimSpace(tag.Value + " " + trimmed)
+				continue
+			}
+		}
+		tag = nil
+		text = append(text, line)
+	}
+
+	doc.Text = strings.TrimSpace(strings.Join(text, "\n"))
+	doc.Summary, doc.Body = splitSummary(doc.Text)
+	for _, tag := range doc.Tags {
+		switch tag.Name {
+		case "deprecated":
+			doc.Deprecated = true
+			doc.DeprecationNote = tag.Value
+		case "since":
+			doc.Since = tag.Value
+		case "owner":
+			doc.Owner = tag.Value
+		case "pii":
+			doc.PII = true
+		case "see":
+			doc.See = append(doc.See, tag.Value)
+		}
+	}
+	doc.Links = parseLinks(doc.Text, doc.See)
+	return doc
+}
+
+// splitSummary splits text into the summary and the rest.
+func splitSummary(text string) (summary, body string) {
+	paragraph := text
+	if idx := strings.Index(text, "\n\n"); idx >= 0 {
+		paragraph = text[:idx]
+	}
+	if strings.HasPrefix(paragraph, "```") || strings.HasPrefix(paragraph, "~~~") {
+		// A code block is never a summary.
+		return "", text
+	}
+	end := len(paragraph)
+	if loc := sentenceEndRegexp.FindStringIndex(paragraph); loc != nil {
+		end = loc[0] + 1
+	}
+	summary = strings.Join(strings.Fields(paragraph[:end]), " ")
+	body = strings.TrimSpace(text[end:])
+	return summary, body
+}
+
+// parseDocs fills Doc fields of all elements of the schema from their comments.
+func (s *Schema) parseDocs() {
+	for _, keyspace := range s.Keyspaces {
+		keyspace.Doc = ParseDoc(keyspace.Comment)
+		for _, table := range keyspace.Tables {
+			table.Doc = ParseDoc(table.Comment)
+			for _, column := range table.Columns {
+				column.Doc = ParseDoc(column.Comment)
+			}
+			for _, index := range table.Indexes {
+				index.Doc = ParseDoc(index.Comment)
+			}
+		}
+		for _, view := range keyspace.Views {
+			view.Doc = ParseDoc(view.Comment)
+			for _, column := range view.Columns {
+				column.Doc = ParseDoc(column.Comment)
+			}
+		}
+		for _, t := range keyspace.Types {
+			t.Doc = ParseDoc(t.Comment)
+			for _, field := range t.Fields {
+				field.Doc = ParseDoc(field.Comment)
+			}
+		}
+	}
+}
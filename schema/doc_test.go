@@ -0,0 +1,116 @@
+package schema
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestParseDoc(t *testing.T) {
+	doc := ParseDoc(`Users of the application. Rows are never deleted.
+
+Second paragraph
+spans two lines.
+@deprecated use accounts
+  instead
+@since 1.4
+@owner team-x
+@pii
+@see ks.accounts
+@see ks.sessions.user_id
+@custom value`)
+	assert.Equal(t, &Doc{
+		Text: "Users of the application. Rows are never deleted.\n\nSecond paragraph\nspans two lines.",
+		Summary: "Users of the application.",
+		Body: "Rows are never deleted.\n\nSecond paragraph\nspans two lines.",
+		Deprecated: true,
+		DeprecationNote: "use accounts instead",
+		Since: "1.4",
+		Owner: "team-x",
+		PII: true,
+		See: []string{"ks.accounts", "ks.sessions.user_id"},
+		Links: []*Link{{Target: "ks.accounts"}, {Target: "ks.sessions.user_id"}},
+		Tags: []DocTag{
+			{Name: "deprecated", Value: "use accounts instead"},
+			{Name: "since", Value: "1.4"},
+			{Name: "owner", Value: "team-x"},
+			{Name: "pii"},
+			{Name: "see", Value: "ks.accounts"},
+			{Name: "see", Value: "ks.sessions.user_id"},
+			{Name: "custom", Value: "value"},
+		},
+	}, doc)
+}
+
+func TestParseDocSummaryParagraph(t *testing.T) {
+	doc := ParseDoc("Summary without\nsentence end\n\nBody.")
+	assert.Equal(t, "Summary without sentence end", doc.Summary)
+	assert.Equal(t, "Body.", doc.Body)
+	assert.Empty(t, doc.Tags)
+}
+
+func TestParseDocCodeBlock(t *testing.T) {
+	doc := ParseDoc("Example query.\n\n```\n@since is not a tag here\n```\n@since 2.0")
+	assert.Equal(t, "Example query.", doc.Summary)
+	assert.Equal(t, "```\n@since is not a tag here\n```", doc.Body)
+	assert.Equal(t, "2.0", doc.Since)
+	assert.Equal(t, 1, len(doc.Tags))
+}
+
+func TestParseDocEmpty(t *testing.T) {
+	assert.Equal(t, &Doc{}, ParseDoc(""))
+	assert.Equal(t, &Doc{PII: true, Tags: []DocTag{{Name: "pii"}}}, ParseDoc("@pii"))
+}
+
+func TestWithDocComments(t *testing.T) {
+	cql := `-- Users of the application.
+-- @owner team-x
+CREATE TABLE ks.users (
+	-- E-mail address.
+	-- @pii
+	email text PRIMARY KEY,
+	name text
+);
+ALTER TABLE ks.users ADD
+	-- @deprecated
+	nick text;`
+	schema, err := ParseString(cql)
+	require.NoError(t, err)
+	assert.Nil(t, schema.GetTable("ks", "users").Doc)
+
+	schema, err = ParseString(cql, WithDocComments())
+	require.NoError(t, err)
+	table := schema.GetTable("ks", "users")
+	require.NotNil(t, table.Doc)
+	assert.Equal(t, "Users of the application.", table.Doc.Summary)
+	assert.Equal(t, "team-x", table.Doc.Owner)
+	assert.True(t, table.GetColumn("email").Doc.PII)
+	assert.Equal(t, "E-mail address.", table.GetColumn("email").Doc.Summary)
+	assert.Equal(t, &Doc{}, table.GetColumn("name").Doc)
+	assert.True(t, table.GetColumn("nick").Doc.Deprecated)
+	assert.NotNil(t, schema.GetKeyspace("ks").Doc)
+}
+
+func TestJavadocComments(t *testing.T) {
+	schema, err := ParseString(`/** Users table. More.
+ * @since 1.4
+ */
+CREATE TABLE ks.users (
+	/**
+	 * E-mail address.
+	 *
+	 * @pii
+	 */
+	email text PRIMARY KEY
+);`, WithDocComments())
+	require.NoError(t, err)
+	table := schema.GetTable("ks", "users")
+	assert.Equal(t, "Users table. More.\n@since 1.4", table.Comment)
+	assert.Equal(t, "Users table.", table.Doc.Summary)
+	assert.Equal(t, "More.", table.Doc.Body)
+	assert.Equal(t, "1.4", table.Doc.Since)
+	email := table.GetColumn("email")
+	assert.Equal(t, "E-mail address.\n\n@pii", email.Comment)
+	assert.Equal(t, "E-mail address.", email.Doc.Summary)
+	assert.True(t, email.Doc.PII)
+}
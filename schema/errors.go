@@ -0,0 +1,270 @@
+package schema
+
+import (
+	"fmt"
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+	"strings"
+)
+
+// ParseError is returned by Parse if the input is not a valid schema.
+// The input is not applied to the schema if it contains syntax errors, so SemanticErrors are only reported without them.
+type ParseError struct {
+	// SyntaxErrors are all syntax errors found in the input.
+	SyntaxErrors []*SyntaxError
+	// SemanticErrors are all statements that could not be applied to the schema.
+	SemanticErrors []*SemanticError
+}
+
+func (pe *ParseError) Error() string {
+	errs := pe.Errors()
+	messages := make([]string, len(errs))
+	for i, e := range errs {
+		messages[i] = e.Error()
+	}
+	return strings.Join(messages, "\n")
+}
+
+// Errors returns the individual syntax and semantic errors, first the syntax errors.
+func (pe *ParseError) Errors() []error {
+	var errs []error
+	for _, e := range pe.SyntaxErrors {
+		errs = append(errs, e)
+	}
+	for _, e := range pe.SemanticErrors {
+		errs = append(errs, e)
+	}
+	return errs
+}
+
+// SyntaxError is a location where the input does not match the CQL grammar.
+type SyntaxError struct {
+	Position Position
+	// Token is the text of the offending token.
+	Token string
+	// Expected are the tokens that are valid at the position, if known.
+	// Keywords are listed in uppercase, other tokens by their literal text or their name, for example OBJECT_NAME.
+	Expected []string
+	// Message is the description of the error reported by the parser.
+	Message string
+}
+
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Position, e.Message)
+}
+
+// syntaxErrorListener collects syntax errors reported by the lexer and the parser.
+type syntaxErrorListener struct {
+	*antlr.DefaultErrorListener
+	parser *documentParser
+	errors []*SyntaxError
+}
+
+func (s *syntaxErrorListener) SyntaxError(recognizer antlr.Recognizer, offendingSymbol interface{}, line, column int,
+	msg string, e antlr.RecognitionException) {
+	syntaxError := &SyntaxError{Message: msg}
+	switch r := recognizer.(type) {
+	case antlr.Parser:
+		token := offendingSymbol.(antlr.Token)
+		syntaxError.Position = s.parser.position(token)
+		syntaxError.Token = token.GetText()
+		if _, ok := e.(*antlr.NoViableAltException); !ok {
+			// The parser reports no viable alternative in the state where the prediction started,
+			// so the expected tokens are only known for other errors.
+			syntaxError.Expected = tokenNames(r.GetExpectedTokens(), r)
+		}
+	case *antlr.BaseLexer:
+		syntaxError.Position = s.parser.positionAt(line, column, r.TokenStartCharIndex)
+		input := r.GetInputStream()
+		syntaxError.Token = input.GetTextFromInterval(antlr.NewInterval(r.TokenStartCharIndex, input.Index()))
+	}
+	s.errors = append(s.errors, syntaxError)
+}
+
+// tokenNames returns the names of tokens in the set.
+func tokenNames(set *antlr.IntervalSet, recognizer antlr.Recognizer) []string {
+	if set == nil {
+		return nil
+	}
+	s := set.StringVerbose(recognizer.GetLiteralNames(), recognizer.GetSymbolicNames(), false)
+	if s == "{}" {
+		return nil
+	}
+	if strings.HasPrefix(s, "{") {
+		s = s[1 : len(s)-1]
+	}
+	names := strings.Split(s, ", ")
+	for i, name := range names {
+		names[i] = strings.TrimPrefix(name, "K_")
+	}
+	return names
+}
+
+// SemanticError is a statement that is valid CQL, but can't be applied to the schema,
+// for example because it alters a table that does not exist.
+type SemanticError struct {
+	Kind ErrorKind
+	// Position is the location of the statement.
+	Position Position
+	// Keyspace is the keyspace of the object the statement refers to.
+	Keyspace string
+	// Object is the name of the table, materialized view, type or index the statement refers to.
+	// It is empty if the error relates to the keyspace itself.
+	Object string
+	// Column is the name of the column or the field the error relates to, if any.
+	Column string
+	Message string
+}
+
+func (e *SemanticError) Error() string {
+	var names []string
+	for _, name := range []string{e.Keyspace, e.Object, e.Column} {
+		if name != "" {
+			names = append(names, name)
+		}
+	}
+	if len(names) == 0 {
+		return fmt.Sprintf("%s: %s", e.Position, e.Message)
+	}
+	return fmt.Sprintf("%s: %s: %s", e.Position, e.Message, strings.Join(names, "."))
+}
+
+// ErrorKind is the category of a semantic error.
+type ErrorKind int
+
+const (
+	// ErrorKindUnknownKeyspace is a reference to a keyspace that does not exist.
+	ErrorKindUnknownKeyspace ErrorKind = iota
+	// ErrorKindUnknownTable is a reference to a table that does not exist.
+	ErrorKindUnknownTable
+	// ErrorKindUnknownView is a reference to a materialized view that does not exist.
+	ErrorKindUnknownView
+	// ErrorKindUnknownType is a reference to a user-defined type that does not exist.
+	ErrorKindUnknownType
+	// ErrorKindUnknownIndex is a reference to a secondary index that does not exist.
+	ErrorKindUnknownIndex
+	// ErrorKindUnknownColumn is a reference to a column or a field that does not exist.
+	ErrorKindUnknownColumn
+	// ErrorKindDuplicateKeyspace is a keyspace created twice.
+	ErrorKindDuplicateKeyspace
+	// ErrorKindDuplicateTable is a table created twice or with the name of a materialized view.
+	ErrorKindDuplicateTable
+	// ErrorKindDuplicateView is a materialized view created twice or with the name of a table.
+	ErrorKindDuplicateView
+	// ErrorKindDuplicateType is a user-defined type created twice.
+	ErrorKindDuplicateType
+	// ErrorKindDuplicateIndex is a secondary index created twice.
+	ErrorKindDuplicateIndex
+	// ErrorKindDuplicateColumn is a column or a field defined twice.
+	ErrorKindDuplicateColumn
+	// ErrorKindInvalidPrimaryKey is a primary key or a clustering order that is not valid.
+	ErrorKindInvalidPrimaryKey
+	// ErrorKindKeyColumn is a change of a primary key column that Cassandra does not allow.
+	ErrorKindKeyColumn
+	// ErrorKindStaticColumn is a static column that is not allowed in the table.
+	ErrorKindStaticColumn
+	// ErrorKindIndexedColumn is a change of a column that has a secondary index.
+	ErrorKindIndexedColumn
+	// ErrorKindDependentView is a change of a table that has materialized views.
+	ErrorKindDependentView
+	// ErrorKindInvalidView is a materialized view that can't be created from its base table.
+	ErrorKindInvalidView
+	// ErrorKindInvalidType is a data type with wrong type arguments.
+	ErrorKindInvalidType
+	// ErrorKindInvalidOption is an option with a value that is not valid.
+	ErrorKindInvalidOption
+)
+
+func (k ErrorKind) String() string {
+	switch k {
+	case ErrorKindUnknownKeyspace:
+		return "unknown keyspace"
+	case ErrorKindUnknownTable:
+		return "unknown table"
+	case ErrorKindUnknownView:
+		return "unknown view"
+	case ErrorKindUnknownType:
+		return "unknown type"
+	case ErrorKindUnknownIndex:
+		return "unknown index"
+	case ErrorKindUnknownColumn:
+		return "unknown column"
+	case ErrorKindDuplicateKeyspace:
+		return "duplicate keyspace"
+	case ErrorKindDuplicateTable:
+		return "duplicate table"
+	case ErrorKindDuplicateView:
+		return "duplicate view"
+	case ErrorKindDuplicateType:
+		return "duplicate type"
+	case ErrorKindDuplicateIndex:
+		return "duplicate index"
+	case ErrorKindDuplicateColumn:
+		return "duplicate column"
+	case ErrorKindInvalidPrimaryKey:
+		return "invalid primary key"
+	case ErrorKindKeyColumn:
+		return "key column"
+	case ErrorKindStaticColumn:
+		return "static column"
+	case ErrorKindIndexedColumn:
+		return "indexed column"
+	case ErrorKindDependentView:
+		return "dependent view"
+	case ErrorKindInvalidView:
+		return "invalid view"
+	case ErrorKindInvalidType:
+		return "invalid type"
+	case ErrorKindInvalidOption:
+		return "invalid option"
+	default:
+		return fmt.Sprintf("ErrorKind(%d)", int(k))
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (k ErrorKind) MarshalText() ([]byte, error) {
+	return []byte(k.String()), nil
+}
+
+// applyStatement applies a single statement to the schema.
+// It returns the error that stopped the statement, if any.
+// Errors raised while a table, materialized view or type is being defined are attributed to it.
+// The schema is restored to the state before the statement if it fails, so that a failed statement has no effect.
+func (l *documentParser) applyStatement(statement antlr.ParseTree) (err *SemanticError) {
+	l.snapshot = l.schema.snapshot()
+	defer func() {
+		recovered := recover()
+		if recovered == nil {
+			return
+		}
+		if _, ok := recovered.(skipStatement); ok {
+			// Nothing was changed and nothing is being defined.
+			return
+		}
+		semanticErr, ok := recovered.(*SemanticError)
+		if !ok {
+			panic(recovered)
+		}
+		semanticErr.Position = l.statementPosition
+		if semanticErr.Object == "" {
+			switch {
+			case l.currentView != nil:
+				semanticErr.Keyspace, semanticErr.Object = l.currentView.Keyspace, l.currentView.Name
+			case l.currentTable != nil:
+				semanticErr.Keyspace, semanticErr.Object = l.currentTable.Keyspace, l.currentTable.Name
+			case l.currentType != nil:
+				semanticErr.Keyspace, semanticErr.Object = l.currentType.Keyspace, l.currentType.Name
+			}
+		}
+		l.currentKeyspace = nil
+		l.currentTable = nil
+		l.currentType = nil
+		l.currentView = nil
+		l.currentOptions = nil
+		l.hasPrimaryKey = false
+		l.snapshot.restore(l.schema)
+		err = semanticErr
+	}()
+	antlr.ParseTreeWalkerDefault.Walk(l, statement)
+	return nil
+}
@@ -0,0 +1,172 @@
+package schema
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"strings"
+	"testing"
+)
+
+func TestSyntaxError(t *testing.T) {
+	schema, err := ParseString(`CREATE TABLE ks.tbl (col1 text PRIMARY KEY);
+CREATE TABLE ks.tbl2 (col1 text PRIMARY KEY, col2);`, WithFileName("schema.cql"))
+	require.Error(t, err)
+	assert.Nil(t, schema)
+	parseErr, ok := err.(*ParseError)
+	require.True(t, ok)
+	require.Equal(t, 1, len(parseErr.SyntaxErrors))
+	syntaxErr := parseErr.SyntaxErrors[0]
+	assert.Equal(t, Position{File: "schema.cql", Line: 2, Column: 50, Offset: 94}, syntaxErr.Position)
+	assert.Equal(t, ")", syntaxErr.Token)
+	assert.Contains(t, syntaxErr.Message, "no viable alternative")
+	assert.Equal(t, "schema.cql:2:50: "+syntaxErr.Message, err.Error())
+}
+
+func TestSyntaxErrorExpected(t *testing.T) {
+	_, err := ParseString(`USE ks; )`)
+	require.Error(t, err)
+	parseErr, ok := err.(*ParseError)
+	require.True(t, ok)
+	require.Equal(t, 1, len(parseErr.SyntaxErrors))
+	assert.Equal(t, ")", parseErr.SyntaxErrors[0].Token)
+	assert.Equal(t, []string{"<EOF>", "'--'"}, parseErr.SyntaxErrors[0].Expected)
+}
+
+func TestSyntaxErrorMultiple(t *testing.T) {
+	_, err := ParseString(`CREATE TABLE ks.tbl (col1 text PRIMARY KEY) @;
+CREATE TABLE ks.tbl2 (col1 text PRIMARY KEY) @;`)
+	require.Error(t, err)
+	parseErr, ok := err.(*ParseError)
+	require.True(t, ok)
+	require.Equal(t, 2, len(parseErr.SyntaxErrors))
+	assert.Equal(t, "@", parseErr.SyntaxErrors[0].Token)
+	assert.Equal(t, Position{Line: 1, Column: 45, Offset: 44}, parseErr.SyntaxErrors[0].Position)
+	assert.Equal(t, "@", parseErr.SyntaxErrors[1].Token)
+	assert.Equal(t, 2, parseErr.SyntaxErrors[1].Position.Line)
+	assert.Equal(t, 2, len(parseErr.Errors()))
+}
+
+func TestSemanticErrors(t *testing.T) {
+	schema, err := ParseString(`CREATE TABLE ks.tbl (col1 text PRIMARY KEY, col2 text);
+ALTER TABLE ks.missing ADD col3 text;
+ALTER TABLE ks.tbl RENAME col3 TO col4;
+ALTER TABLE ks.tbl ADD col2 int;
+CREATE TABLE ks.tbl2 (col1 text PRIMARY KEY, col1 int);
+ALTER TABLE ks.tbl DROP col1;
+CREATE TYPE ks.typ (f1 list<int, int>);`, WithFileName("schema.cql"))
+	require.Error(t, err)
+	assert.Nil(t, schema)
+	parseErr, ok := err.(*ParseError)
+	require.True(t, ok)
+	assert.Empty(t, parseErr.SyntaxErrors)
+	assert.Equal(t, []*SemanticError{
+		{
+			Kind: ErrorKindUnknownTable,
+			Position: Position{File: "schema.cql", Line: 2, Column: 1, Offset: 56},
+			Keyspace: "ks",
+			Object: "missing",
+			Message: "Table not found",
+		},
+		{
+			Kind: ErrorKindUnknownColumn,
+			Position: Position{File: "schema.cql", Line: 3, Column: 1, Offset: 94},
+			Keyspace: "ks",
+			Object: "tbl",
+			Column: "col3",
+			Message: "Column does not exist",
+		},
+		{
+			Kind: ErrorKindDuplicateColumn,
+			Position: Position{File: "schema.cql", Line: 4, Column: 1, Offset: 134},
+			Keyspace: "ks",
+			Object: "tbl",
+			Column: "col2",
+			Message: "Duplicate column found",
+		},
+		{
+			Kind: ErrorKindDuplicateColumn,
+			Position: Position{File: "schema.cql", Line: 5, Column: 1, Offset: 167},
+			Keyspace: "ks",
+			Object: "tbl2",
+			Column: "col1",
+			Message: "Duplicate column found",
+		},
+		{
+			Kind: ErrorKindKeyColumn,
+			Position: Position{File: "schema.cql", Line: 6, Column: 1, Offset: 223},
+			Keyspace: "ks",
+			Object: "tbl",
+			Column: "col1",
+			Message: "Cannot drop primary key column",
+		},
+		{
+			Kind: ErrorKindInvalidType,
+			Position: Position{File: "schema.cql", Line: 7, Column: 1, Offset: 253},
+			Keyspace: "ks",
+			Object: "typ",
+			Message: "Type list requires 1 type arguments",
+		},
+	}, parseErr.SemanticErrors)
+	assert.Equal(t, "schema.cql:3:1: Column does not exist: ks.tbl.col3", parseErr.SemanticErrors[1].Error())
+	assert.Equal(t, 6, len(parseErr.Errors()))
+}
+
+func TestSemanticErrorKeyspace(t *testing.T) {
+	_, err := ParseString(`DROP KEYSPACE ks;`)
+	require.Error(t, err)
+	assert.Equal(t, "1:1: Keyspace not found: ks", err.Error())
+}
+
+func TestTableRenameColumnError(t *testing.T) {
+	table := &Table{Keyspace: "ks", Name: "tbl", Columns: []*Column{{Name: "col1"}}}
+	err := table.RenameColumn("col2", "col3")
+	require.Error(t, err)
+	semanticErr, ok := err.(*SemanticError)
+	require.True(t, ok)
+	assert.Equal(t, ErrorKindUnknownColumn, semanticErr.Kind)
+	assert.Equal(t, "col2", semanticErr.Column)
+	require.NoError(t, table.RenameColumn("col1", "col3"))
+	assert.Equal(t, "col3", table.Columns[0].Name)
+}
+
+func TestFailedStatementHasNoEffect(t *testing.T) {
+	var schema Schema
+	err := schema.Apply(strings.NewReader(`CREATE TABLE ks.base (id int PRIMARY KEY, name text);
+CREATE TABLE ks.dup (a int, a text, PRIMARY KEY (a));
+CREATE TABLE ks.stat (a int PRIMARY KEY, b text STATIC);
+CREATE MATERIALIZED VIEW ks.by_name AS SELECT name FROM ks.base WHERE name IS NOT NULL PRIMARY KEY (name);
+ALTER TABLE ks.base ADD x int, name text;
+CREATE TABLE IF NOT EXISTS ks.dup (a int PRIMARY KEY);`))
+	require.Error(t, err)
+	assert.Equal(t, 4, len(err.(*ParseError).SemanticErrors))
+
+	assert.Nil(t, schema.GetTable("ks", "stat"))
+	assert.Nil(t, schema.GetView("ks", "by_name"))
+	base := schema.GetTable("ks", "base")
+	require.NotNil(t, base)
+	assert.Empty(t, base.Views)
+	assert.Nil(t, base.GetColumn("x"))
+	assert.Empty(t, base.Alterations)
+	// The failed CREATE TABLE did not add the table, so the later IF NOT EXISTS statement creates it.
+	dup := schema.GetTable("ks", "dup")
+	require.NotNil(t, dup)
+	assert.Equal(t, 1, len(dup.Columns))
+	assert.Equal(t, "int", dup.Columns[0].CqlType)
+}
+
+func TestFailedAlterTableHasNoEffectOnViews(t *testing.T) {
+	var schema Schema
+	err := schema.Apply(strings.NewReader(`CREATE TABLE ks.base (id int PRIMARY KEY, name text);
+CREATE MATERIALIZED VIEW ks.all_by_id AS SELECT * FROM ks.base WHERE id IS NOT NULL PRIMARY KEY (id);
+ALTER TABLE ks.base ADD x int, name text;
+DROP INDEX ks.missing;`))
+	require.Error(t, err)
+	assert.Equal(t, 2, len(err.(*ParseError).SemanticErrors))
+
+	view := schema.GetView("ks", "all_by_id")
+	require.NotNil(t, view)
+	assert.Equal(t, 2, len(view.Columns))
+	assert.Nil(t, view.GetColumn("x"))
+	assert.Empty(t, view.Alterations)
+	assert.Equal(t, 2, len(schema.GetTable("ks", "base").Columns))
+}
@@ -0,0 +1,124 @@
+package schema
+
+import (
+	"io/fs"
+	"os"
+	"path"
+	"sort"
+	"strings"
+)
+
+// ParseFiles parses CQL files in the given order into a single schema, as if they were applied one after another.
+// Each file starts without a keyspace selected by the USE statement.
+// Positions of schema elements and errors contain the name of the file.
+func ParseFiles(names []string, opts ...ParseOption) (*Schema, error) {
+	return parseFiles(names, func(name string) (fs.File, error) {
+		return os.Open(name)
+	}, opts)
+}
+
+// ParseFS parses all .cql files in the root directory of fsys into a single schema.
+// The files are applied in the order of CQLFileNames.
+// Use fs.Sub to parse files in a subdirectory.
+func ParseFS(fsys fs.FS, opts ...ParseOption) (*Schema, error) {
+	names, err := CQLFileNames(fsys)
+	if err != nil {
+		return nil, err
+	}
+	return parseFiles(names, fsys.Open, opts)
+}
+
+// CQLFileNames returns names of the .cql files in the root directory of fsys, sorted by SortFileNames,
+// so migrations numbered like 1_init.cql, 2_users.cql, ..., 10_orders.cql are in the order of their numbers.
+func CQLFileNames(fsys fs.FS) ([]string, error) {
+	entries, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	for _, entry := range entries {
+		if !entry.IsDir() && strings.EqualFold(path.Ext(entry.Name()), ".cql") {
+			names = append(names, entry.Name())
+		}
+	}
+	SortFileNames(names)
+	return names, nil
+}
+
+// parseFiles applies the files to a new schema.
+// Errors in all files are collected into a single *ParseError.
+func parseFiles(names []string, open func(name string) (fs.File, error), opts []ParseOption) (*Schema, error) {
+	schema := &Schema{}
+	var parseErr ParseError
+	for _, name := range names {
+		f, err := open(name)
+		if err != nil {
+			return nil, err
+		}
+		err = schema.Apply(f, append(opts[:len(opts):len(opts)], WithFileName(name))...)
+		f.Close()
+		if err != nil {
+			fileErr, ok := err.(*ParseError)
+			if !ok {
+				return nil, err
+			}
+			parseErr.SyntaxErrors = append(parseErr.SyntaxErrors, fileErr.SyntaxErrors...)
+			parseErr.SemanticErrors = append(parseErr.SemanticErrors, fileErr.SemanticErrors...)
+		}
+	}
+	if len(parseErr.SyntaxErrors) > 0 || len(parseErr.SemanticErrors) > 0 {
+		return nil, &parseErr
+	}
+	return schema, nil
+}
+
+// SortFileNames sorts file names in natural order.
+// Runs of digits are compared by their numeric value and other text is compared as is,
+// so 2_users.cql sorts before 10_orders.cql and V1.2__x.cql sorts before V1.10__x.cql.
+func SortFileNames(names []string) {
+	sort.SliceStable(names, func(i, j int) bool {
+		return naturalLess(names[i], names[j])
+	})
+}
+
+// naturalLess returns true if a sorts before b in natural order.
+func naturalLess(a, b string) bool {
+	for a != "" && b != "" {
+		aChunk, aDigits := nextChunk(a)
+		bChunk, bDigits := nextChunk(b)
+		a, b = a[len(aChunk):], b[len(bChunk):]
+		if aDigits && bDigits {
+			aNumber := strings.TrimLeft(aChunk, "0")
+			bNumber := strings.TrimLeft(bChunk, "0")
+			if len(aNumber) != len(bNumber) {
+				return len(aNumber) < len(bNumber)
+			}
+			if aNumber != bNumber {
+				return aNumber < bNumber
+			}
+			// Equal numbers with more leading zeros sort first.
+			if len(aChunk) != len(bChunk) {
+				return len(aChunk) > len(bChunk)
+			}
+			continue
+		}
+		if aChunk != bChunk {
+			return aChunk < bChunk
+		}
+	}
+	return len(a) < len(b)
+}
+
+// nextChunk returns the leading run of digits or non-digits of s.
+func nextChunk(s string) (chunk string, digits bool) {
+	digits = isDigit(s[0])
+	i := 1
+	for i < len(s) && isDigit(s[i]) == digits {
+		i++
+	}
+	return s[:i], digits
+}
+
+func isDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
@@ -0,0 +1,117 @@
+package schema
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+)
+
+func TestSortFileNames(t *testing.T) {
+	names := []string{
+		"10_orders.cql",
+		"2_users.cql",
+		"0001_init.cql",
+		"V1.10__x.cql",
+		"V1.2__x.cql",
+		"1_init.cql",
+		"2_users_b.cql",
+	}
+	SortFileNames(names)
+	assert.Equal(t, []string{
+		"0001_init.cql",
+		"1_init.cql",
+		"2_users.cql",
+		"2_users_b.cql",
+		"10_orders.cql",
+		"V1.2__x.cql",
+		"V1.10__x.cql",
+	}, names)
+}
+
+func TestParseFS(t *testing.T) {
+	fsys := fstest.MapFS{
+		"10_add_column.cql": {Data: []byte(`ALTER TABLE ks.tbl ADD col3 int;`)},
+		"2_create_table.cql": {Data: []byte(`USE ks;
+CREATE TABLE tbl (col1 text PRIMARY KEY, col2 text);`)},
+		"1_init.cql": {Data: []byte(`CREATE KEYSPACE ks WITH replication = {'class': 'SimpleStrategy', 'replication_factor': 1};`)},
+		"notes.txt": {Data: []byte(`not a migration`)},
+		"sub/3_ignored.cql": {Data: []byte(`DROP TABLE ks.tbl;`)},
+	}
+	schema, err := ParseFS(fsys)
+	require.NoError(t, err)
+	require.NotNil(t, schema)
+	table := schema.GetTable("ks", "tbl")
+	require.NotNil(t, table)
+	require.Equal(t, 3, len(table.Columns))
+	assert.Equal(t, "col3", table.Columns[2].Name)
+	assert.Equal(t, Position{File: "2_create_table.cql", Line: 2, Column: 1, Offset: 8}, table.Position)
+	assert.Equal(t, "10_add_column.cql", table.Alterations[0].File)
+}
+
+func TestCQLFileNames(t *testing.T) {
+	fsys := fstest.MapFS{
+		"10_orders.CQL": {Data: []byte(``)},
+		"2_users.cql": {Data: []byte(``)},
+		"notes.txt": {Data: []byte(``)},
+		"sub/1_init.cql": {Data: []byte(``)},
+		"dir.cql/3_ignored.cql": {Data: []byte(``)},
+	}
+	names, err := CQLFileNames(fsys)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"2_users.cql", "10_orders.CQL"}, names)
+}
+
+func TestParseFSErrors(t *testing.T) {
+	fsys := fstest.MapFS{
+		"1_create_table.cql": {Data: []byte(`CREATE TABLE ks.tbl (col1 text PRIMARY KEY);`)},
+		"2_broken.cql": {Data: []byte(`CREATE TABLE ks.tbl2 (col1 text PRIMARY KEY, col2);`)},
+		"3_alter.cql": {Data: []byte(`
+ALTER TABLE ks.missing ADD col3 int;`)},
+	}
+	schema, err := ParseFS(fsys)
+	require.Error(t, err)
+	assert.Nil(t, schema)
+	parseErr, ok := err.(*ParseError)
+	require.True(t, ok)
+	require.Equal(t, 1, len(parseErr.SyntaxErrors))
+	assert.Equal(t, "2_broken.cql", parseErr.SyntaxErrors[0].Position.File)
+	require.Equal(t, 1, len(parseErr.SemanticErrors))
+	assert.Equal(t, Position{File: "3_alter.cql", Line: 2, Column: 1, Offset: 1}, parseErr.SemanticErrors[0].Position)
+}
+
+func TestParseFiles(t *testing.T) {
+	dir := t.TempDir()
+	first := filepath.Join(dir, "b.cql")
+	second := filepath.Join(dir, "a.cql")
+	require.NoError(t, ioutil.WriteFile(first, []byte(`CREATE TABLE ks.tbl (col1 text PRIMARY KEY);`), 0644))
+	require.NoError(t, ioutil.WriteFile(second, []byte(`USE ks;
+ALTER TABLE tbl ADD col2 int;`), 0644))
+
+	schema, err := ParseFiles([]string{first, second})
+	require.NoError(t, err)
+	table := schema.GetTable("ks", "tbl")
+	require.NotNil(t, table)
+	assert.Equal(t, first, table.Position.File)
+	assert.Equal(t, []Position{{File: second, Line: 2, Column: 1, Offset: 8}}, table.Alterations)
+}
+
+func TestParseFilesUse(t *testing.T) {
+	dir := t.TempDir()
+	first := filepath.Join(dir, "1.cql")
+	second := filepath.Join(dir, "2.cql")
+	require.NoError(t, ioutil.WriteFile(first, []byte(`USE ks;
+CREATE TABLE tbl (col1 text PRIMARY KEY);`), 0644))
+	require.NoError(t, ioutil.WriteFile(second, []byte(`ALTER TABLE tbl ADD col2 int;`), 0644))
+
+	// The keyspace selected by USE does not carry over to the next file.
+	_, err := ParseFiles([]string{first, second})
+	require.Error(t, err)
+	parseErr, ok := err.(*ParseError)
+	require.True(t, ok)
+	require.Equal(t, 1, len(parseErr.SemanticErrors))
+	assert.Equal(t, ErrorKindUnknownTable, parseErr.SemanticErrors[0].Kind)
+	assert.Equal(t, second, parseErr.SemanticErrors[0].Position.File)
+}
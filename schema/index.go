@@ -0,0 +1,208 @@
+package schema
+
+import (
+	"fmt"
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+	"github.com/martin-sucha/cqldoc/parser"
+	"reflect"
+	"regexp"
+)
+
+// Index is a secondary index on a column of a table.
+type Index struct {
+	Comment string
+	// Doc is the parsed Comment, set if the schema was parsed with WithDocComments.
+	Doc *Doc
+	Keyspace string
+	// Name is the name of the index.
+	// Cassandra generates the name <table>_<column>_idx if the statement does not specify one.
+	Name string
+	// Table is the name of the indexed table.
+	Table string
+	// Column is the name of the indexed column.
+	Column string
+	// Kind is the part of the column value that is indexed.
+	Kind IndexKind
+	// Position is the location of the statement that created the index.
+	Position Position
+}
+
+// IndexKind describes which part of a column value is indexed.
+type IndexKind int
+
+const (
+	// IndexKindValues indexes the column value or values of a collection column.
+	IndexKindValues IndexKind = iota
+	// IndexKindKeys indexes keys of a map column.
+	IndexKindKeys
+	// IndexKindEntries indexes entries of a map column.
+	IndexKindEntries
+	// IndexKindFull indexes whole values of a frozen collection column.
+	IndexKindFull
+)
+
+func (k IndexKind) String() string {
+	switch k {
+	case IndexKindValues:
+		return "values"
+	case IndexKindKeys:
+		return "keys"
+	case IndexKindEntries:
+		return "entries"
+	case IndexKindFull:
+		return "full"
+	default:
+		return fmt.Sprintf("IndexKind(%d)", int(k))
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (k IndexKind) MarshalText() ([]byte, error) {
+	return []byte(k.String()), nil
+}
+
+// GetIndex finds a secondary index with the keyspace and name.
+// Returns nil if not found.
+func (s *Schema) GetIndex(keyspace, name string) *Index {
+	k := s.GetKeyspace(keyspace)
+	if k == nil {
+		return nil
+	}
+	return k.GetIndex(name)
+}
+
+// GetIndex finds a secondary index by name.
+// Index names are unique within a keyspace.
+// Returns nil if not found.
+func (k *Keyspace) GetIndex(name string) *Index {
+	for _, table := range k.Tables {
+		for _, index := range table.Indexes {
+			if index.Name == name {
+				return index
+			}
+		}
+	}
+	return nil
+}
+
+// dropIndex removes a secondary index from its table.
+// Returns false if not found.
+func (k *Keyspace) dropIndex(name string) bool {
+	for _, table := range k.Tables {
+		for idx, index := range table.Indexes {
+			if index.Name == name {
+				copy(table.Indexes[idx:], table.Indexes[idx+1:])
+				table.Indexes[len(table.Indexes)-1] = nil
+				table.Indexes = table.Indexes[:len(table.Indexes)-1]
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// equivalentIndex finds an index of the column with the kind.
+// Returns nil if not found.
+func (t *Table) equivalentIndex(column string, kind IndexKind) *Index {
+	for _, index := range t.Indexes {
+		if index.Column == column && index.Kind == kind {
+			return index
+		}
+	}
+	return nil
+}
+
+var nonWordRegexp = regexp.MustCompile(`\W`)
+
+// defaultIndexName returns the name Cassandra assigns to an index created without a name.
+func (k *Keyspace) defaultIndexName(table, column string) string {
+	base := nonWordRegexp.ReplaceAllString(table+"_"+column+"_idx", "")
+	name := base
+	for i := 1; k.GetIndex(name) != nil; i++ {
+		name = fmt.Sprintf("%s_%d", base, i)
+	}
+	return name
+}
+
+// getIndexName returns the name of an index specified by ctx.
+func getIndexName(ctx *parser.IndexNameContext) string {
+	if literal := ctx.StringLiteral(); literal != nil {
+		return unquoteString(literal.GetText())
+	}
+	return ctx.GetText()
+}
+
+func (l *documentParser) EnterCreateIndex(ctx *parser.CreateIndexContext) {
+	tokens := l.stream.GetHiddenTokensToLeft(ctx.GetStart().GetTokenIndex(), antlr.TokenHiddenChannel)
+	comment := getComment(tokens)
+
+	keyspaceName, tableName := l.getQualifiedName(ctx, reflect.TypeOf(&parser.TableContext{}))
+	table := l.schema.GetTable(keyspaceName, tableName)
+	if table == nil {
+		panic(&SemanticError{Kind: ErrorKindUnknownTable, Keyspace: keyspaceName, Object: tableName, Message: "Table not found"})
+	}
+
+	spec := ctx.IndexColumnSpec().(*parser.IndexColumnSpecContext)
+	var column string
+	var kind IndexKind
+	switch {
+	case spec.IndexKeysSpec() != nil:
+		column = spec.IndexKeysSpec().(*parser.IndexKeysSpecContext).OBJECT_NAME().GetText()
+		kind = IndexKindKeys
+	case spec.IndexEntriesSSpec() != nil:
+		column = spec.IndexEntriesSSpec().(*parser.IndexEntriesSSpecContext).OBJECT_NAME().GetText()
+		kind = IndexKindEntries
+	case spec.IndexFullSpec() != nil:
+		column = spec.IndexFullSpec().(*parser.IndexFullSpecContext).OBJECT_NAME().GetText()
+		kind = IndexKindFull
+	default:
+		column = spec.Column().GetText()
+		kind = IndexKindValues
+	}
+	if table.GetColumn(column) == nil {
+		panic(table.columnError(ErrorKindUnknownColumn, column, "Column does not exist"))
+	}
+
+	// Like Cassandra, an index of the same column and kind is a duplicate regardless of the name.
+	if existing := table.equivalentIndex(column, kind); existing != nil {
+		if ctx.IfNotExist() != nil {
+			return
+		}
+		panic(table.columnError(ErrorKindDuplicateIndex, column, fmt.Sprintf("Duplicate of existing index %s", existing.Name)))
+	}
+
+	keyspace := l.schema.keyspace(keyspaceName)
+	var name string
+	if nameCtx := ctx.IndexName(); nameCtx != nil {
+		name = getIndexName(nameCtx.(*parser.IndexNameContext))
+	} else {
+		name = keyspace.defaultIndexName(tableName, column)
+	}
+	duplicate := &SemanticError{Kind: ErrorKindDuplicateIndex, Keyspace: keyspaceName, Object: name, Message: "Duplicate index found"}
+	checkCreate(keyspace.GetIndex(name) != nil, ctx.IfNotExist(), duplicate)
+
+	l.snapshot.saveTable(table)
+	table.Indexes = append(table.Indexes, &Index{
+		Comment: comment,
+		Keyspace: keyspaceName,
+		Name: name,
+		Table: tableName,
+		Column: column,
+		Kind: kind,
+		Position: l.statementPosition,
+	})
+}
+
+func (l *documentParser) EnterDropIndex(ctx *parser.DropIndexContext) {
+	keyspaceName, _ := l.getQualifiedName(ctx, reflect.TypeOf(&parser.IndexNameContext{}))
+	name := getIndexName(ctx.IndexName().(*parser.IndexNameContext))
+	keyspace := l.schema.GetKeyspace(keyspaceName)
+	if keyspace != nil {
+		if index := keyspace.GetIndex(name); index != nil {
+			l.snapshot.saveTable(keyspace.GetTable(index.Table))
+		}
+	}
+	if (keyspace == nil || !keyspace.dropIndex(name)) && ctx.IfExist() == nil {
+		panic(&SemanticError{Kind: ErrorKindUnknownIndex, Keyspace: keyspaceName, Object: name, Message: "Index not found"})
+	}
+}
@@ -0,0 +1,148 @@
+package schema
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+const indexBaseTable = `CREATE TABLE sp.users (
+	id uuid PRIMARY KEY,
+	email text,
+	tags set<text>,
+	attrs map<text, text>,
+	addresses frozen<address>
+);
+`
+
+func TestCreateIndex(t *testing.T) {
+	schema, err := ParseString(indexBaseTable + `
+-- lookup by email
+CREATE INDEX users_email ON sp.users (email);
+CREATE INDEX ON sp.users (tags);
+CREATE INDEX attr_keys ON sp.users (KEYS(attrs));
+CREATE INDEX attr_entries ON sp.users (ENTRIES(attrs));
+CREATE INDEX 'addr' ON sp.users (FULL(addresses));
+`)
+	require.NoError(t, err)
+	require.NotNil(t, schema)
+	table := schema.GetTable("sp", "users")
+	require.NotNil(t, table)
+	require.Equal(t, 5, len(table.Indexes))
+
+	index := schema.GetIndex("sp", "users_email")
+	require.NotNil(t, index)
+	assert.Equal(t, table.Indexes[0], index)
+	assert.Equal(t, "lookup by email", index.Comment)
+	assert.Equal(t, "sp", index.Keyspace)
+	assert.Equal(t, "users", index.Table)
+	assert.Equal(t, "email", index.Column)
+	assert.Equal(t, IndexKindValues, index.Kind)
+
+	assert.Equal(t, "users_tags_idx", table.Indexes[1].Name)
+	assert.Equal(t, "tags", table.Indexes[1].Column)
+	assert.Equal(t, IndexKindValues, table.Indexes[1].Kind)
+	assert.Equal(t, "attr_keys", table.Indexes[2].Name)
+	assert.Equal(t, "attrs", table.Indexes[2].Column)
+	assert.Equal(t, IndexKindKeys, table.Indexes[2].Kind)
+	assert.Equal(t, IndexKindEntries, table.Indexes[3].Kind)
+	assert.Equal(t, "addr", table.Indexes[4].Name)
+	assert.Equal(t, IndexKindFull, table.Indexes[4].Kind)
+
+	assert.Equal(t, []*Index{index}, table.ColumnIndexes("email"))
+	assert.Equal(t, 2, len(table.ColumnIndexes("attrs")))
+	assert.Empty(t, table.ColumnIndexes("id"))
+}
+
+func TestCreateIndexDefaultName(t *testing.T) {
+	schema, err := ParseString(indexBaseTable + `USE sp;
+CREATE INDEX ON users (KEYS(attrs));
+CREATE INDEX ON users (ENTRIES(attrs));
+`)
+	require.NoError(t, err)
+	require.NotNil(t, schema)
+	table := schema.GetTable("sp", "users")
+	require.Equal(t, 2, len(table.Indexes))
+	assert.Equal(t, "users_attrs_idx", table.Indexes[0].Name)
+	assert.Equal(t, "users_attrs_idx_1", table.Indexes[1].Name)
+}
+
+func TestCreateIndexNonExistingColumn(t *testing.T) {
+	schema, err := ParseString(indexBaseTable + `CREATE INDEX ON sp.users (phone);`)
+	require.Error(t, err)
+	require.Nil(t, schema)
+}
+
+func TestCreateIndexNonExistingTable(t *testing.T) {
+	schema, err := ParseString(`CREATE INDEX ON sp.users (email);`)
+	require.Error(t, err)
+	require.Nil(t, schema)
+}
+
+func TestCreateIndexDuplicate(t *testing.T) {
+	schema, err := ParseString(indexBaseTable + `CREATE INDEX idx ON sp.users (email);
+CREATE INDEX idx ON sp.users (tags);
+`)
+	require.Error(t, err)
+	require.Nil(t, schema)
+
+	schema, err = ParseString(indexBaseTable + `CREATE INDEX idx ON sp.users (email);
+CREATE INDEX IF NOT EXISTS idx ON sp.users (tags);
+`)
+	require.NoError(t, err)
+	require.NotNil(t, schema)
+	index := schema.GetIndex("sp", "idx")
+	require.NotNil(t, index)
+	assert.Equal(t, "email", index.Column)
+}
+
+func TestCreateIndexEquivalent(t *testing.T) {
+	schema, err := ParseString(indexBaseTable + `CREATE INDEX IF NOT EXISTS ON sp.users (email);
+CREATE INDEX IF NOT EXISTS ON sp.users (email);
+CREATE INDEX IF NOT EXISTS named ON sp.users (email);
+`)
+	require.NoError(t, err)
+	table := schema.GetTable("sp", "users")
+	require.Equal(t, 1, len(table.Indexes))
+	assert.Equal(t, "users_email_idx", table.Indexes[0].Name)
+
+	_, err = ParseString(indexBaseTable + `CREATE INDEX ON sp.users (email);
+CREATE INDEX ON sp.users (email);
+`)
+	require.Error(t, err)
+	semanticErr := err.(*ParseError).SemanticErrors[0]
+	assert.Equal(t, ErrorKindDuplicateIndex, semanticErr.Kind)
+	assert.Equal(t, "Duplicate of existing index users_email_idx", semanticErr.Message)
+}
+
+func TestDropIndex(t *testing.T) {
+	schema, err := ParseString(indexBaseTable + `CREATE INDEX idx ON sp.users (email);
+DROP INDEX sp.idx;
+DROP INDEX IF EXISTS sp.idx;
+ALTER TABLE sp.users DROP email;
+`)
+	require.NoError(t, err)
+	require.NotNil(t, schema)
+	assert.Nil(t, schema.GetIndex("sp", "idx"))
+	assert.Empty(t, schema.GetTable("sp", "users").Indexes)
+}
+
+func TestDropIndexNonExisting(t *testing.T) {
+	schema, err := ParseString(indexBaseTable + `DROP INDEX sp.idx;`)
+	require.Error(t, err)
+	require.Nil(t, schema)
+}
+
+func TestAlterIndexedColumn(t *testing.T) {
+	schema, err := ParseString(indexBaseTable + `CREATE INDEX ON sp.users (email);
+ALTER TABLE sp.users DROP email;
+`)
+	require.Error(t, err)
+	require.Nil(t, schema)
+
+	schema, err = ParseString(indexBaseTable + `CREATE INDEX ON sp.users (email);
+ALTER TABLE sp.users RENAME email TO mail;
+`)
+	require.Error(t, err)
+	require.Nil(t, schema)
+}
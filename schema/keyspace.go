@@ -0,0 +1,217 @@
+package schema
+
+import (
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+	"github.com/martin-sucha/cqldoc/parser"
+	"reflect"
+	"strings"
+)
+
+// Keyspace groups the schema objects that share replication settings.
+type Keyspace struct {
+	Comment string
+	// Doc is the parsed Comment, set if the schema was parsed with WithDocComments.
+	Doc *Doc
+	Name string
+	// ReplicationClass is the replication strategy, for example NetworkTopologyStrategy.
+	ReplicationClass string
+	// ReplicationOptions are the remaining replication options,
+	// for example replication factors of data centers.
+	ReplicationOptions map[string]string
+	DurableWrites bool
+	// Position is the location of the statement that created the keyspace.
+	Position Position
+	// Alterations are locations of ALTER statements that changed the keyspace.
+	Alterations []Position
+	Tables []*Table
+	Types []*Type
+	Views []*MaterializedView
+	// implicit is true if the keyspace was referenced, but not created by the parsed statements.
+	implicit bool
+}
+
+// GetKeyspace finds a keyspace by name.
+// Returns nil if not found.
+func (s *Schema) GetKeyspace(name string) *Keyspace {
+	for _, keyspace := range s.Keyspaces {
+		if keyspace.Name == name {
+			return keyspace
+		}
+	}
+	return nil
+}
+
+// keyspace returns the keyspace with the name.
+// The keyspace is added to the schema if it does not exist yet.
+func (s *Schema) keyspace(name string) *Keyspace {
+	keyspace := s.GetKeyspace(name)
+	if keyspace == nil {
+		keyspace = &Keyspace{
+			Name: name,
+			DurableWrites: true,
+			implicit: true,
+		}
+		s.Keyspaces = append(s.Keyspaces, keyspace)
+	}
+	return keyspace
+}
+
+// dropKeyspace removes a keyspace with all its objects.
+// Returns false if not found.
+func (s *Schema) dropKeyspace(name string) bool {
+	for idx, keyspace := range s.Keyspaces {
+		if keyspace.Name == name {
+			copy(s.Keyspaces[idx:], s.Keyspaces[idx+1:])
+			s.Keyspaces[len(s.Keyspaces)-1] = nil
+			s.Keyspaces = s.Keyspaces[:len(s.Keyspaces)-1]
+			return true
+		}
+	}
+	return false
+}
+
+// Tables returns tables from all keyspaces.
+func (s *Schema) Tables() []*Table {
+	var ret []*Table
+	for _, keyspace := range s.Keyspaces {
+		ret = append(ret, keyspace.Tables...)
+	}
+	return ret
+}
+
+// Types returns user-defined types from all keyspaces.
+func (s *Schema) Types() []*Type {
+	var ret []*Type
+	for _, keyspace := range s.Keyspaces {
+		ret = append(ret, keyspace.Types...)
+	}
+	return ret
+}
+
+// GetTable finds a table by name.
+// Returns nil if not found.
+func (k *Keyspace) GetTable(name string) *Table {
+	for _, t := range k.Tables {
+		if t.Name == name {
+			return t
+		}
+	}
+	return nil
+}
+
+// dropTable removes a table together with its secondary indexes.
+// Returns false if not found.
+func (k *Keyspace) dropTable(name string) bool {
+	for idx, t := range k.Tables {
+		if t.Name == name {
+			if len(t.Views) > 0 {
+				panic(&SemanticError{
+					Kind: ErrorKindDependentView,
+					Keyspace: k.Name,
+					Object: name,
+					Message: "Cannot drop table with materialized views",
+				})
+			}
+			copy(k.Tables[idx:], k.Tables[idx+1:])
+			k.Tables[len(k.Tables)-1] = nil
+			k.Tables = k.Tables[:len(k.Tables)-1]
+			return true
+		}
+	}
+	return false
+}
+
+// GetType finds a user-defined type by name.
+// Returns nil if not found.
+func (k *Keyspace) GetType(name string) *Type {
+	for _, t := range k.Types {
+		if t.Name == name {
+			return t
+		}
+	}
+	return nil
+}
+
+func (l *documentParser) EnterCreateKeyspace(ctx *parser.CreateKeyspaceContext) {
+	tokens := l.stream.GetHiddenTokensToLeft(ctx.GetStart().GetTokenIndex(), antlr.TokenHiddenChannel)
+	comment := getComment(tokens)
+
+	name := ctx.GetChildOfType(0, reflect.TypeOf(&parser.KeyspaceContext{})).GetText()
+	keyspace := l.schema.GetKeyspace(name)
+	duplicate := &SemanticError{Kind: ErrorKindDuplicateKeyspace, Keyspace: name, Message: "Duplicate keyspace found"}
+	checkCreate(keyspace != nil && !keyspace.implicit, ctx.IfNotExist(), duplicate)
+	keyspace = l.schema.keyspace(name)
+	l.snapshot.saveKeyspace(keyspace)
+	keyspace.Comment = comment
+	keyspace.Position = l.statementPosition
+	keyspace.implicit = false
+	l.currentKeyspace = keyspace
+}
+
+func (l *documentParser) ExitCreateKeyspace(ctx *parser.CreateKeyspaceContext) {
+	l.currentKeyspace = nil
+}
+
+func (l *documentParser) EnterAlterKeyspace(ctx *parser.AlterKeyspaceContext) {
+	name := ctx.GetChildOfType(0, reflect.TypeOf(&parser.KeyspaceContext{})).GetText()
+	l.currentKeyspace = l.schema.GetKeyspace(name)
+	if l.currentKeyspace == nil || l.currentKeyspace.implicit {
+		panic(&SemanticError{Kind: ErrorKindUnknownKeyspace, Keyspace: name, Message: "Keyspace not found"})
+	}
+	l.snapshot.saveKeyspace(l.currentKeyspace)
+	l.currentKeyspace.Alterations = append(l.currentKeyspace.Alterations, l.statementPosition)
+}
+
+func (l *documentParser) ExitAlterKeyspace(ctx *parser.AlterKeyspaceContext) {
+	l.currentKeyspace = nil
+}
+
+func (l *documentParser) EnterDropKeyspace(ctx *parser.DropKeyspaceContext) {
+	name := ctx.GetChildOfType(0, reflect.TypeOf(&parser.KeyspaceContext{})).GetText()
+	if !l.schema.dropKeyspace(name) && ctx.IfExist() == nil {
+		panic(&SemanticError{Kind: ErrorKindUnknownKeyspace, Keyspace: name, Message: "Keyspace not found"})
+	}
+}
+
+func (l *documentParser) EnterReplicationList(ctx *parser.ReplicationListContext) {
+	l.currentKeyspace.ReplicationClass = ""
+	l.currentKeyspace.ReplicationOptions = make(map[string]string)
+	for _, child := range ctx.GetChildren() {
+		item, ok := child.(*parser.ReplicationListItemContext)
+		if !ok {
+			continue
+		}
+		key := unquoteString(item.STRING_LITERAL(0).GetText())
+		var value string
+		if item.DECIMAL_LITERAL() != nil {
+			value = item.DECIMAL_LITERAL().GetText()
+		} else {
+			value = unquoteString(item.STRING_LITERAL(1).GetText())
+		}
+		if key == "class" {
+			l.currentKeyspace.ReplicationClass = value
+		} else {
+			l.currentKeyspace.ReplicationOptions[key] = value
+		}
+	}
+}
+
+func (l *documentParser) EnterDurableWrites(ctx *parser.DurableWritesContext) {
+	value := ctx.GetChildOfType(0, reflect.TypeOf(&parser.BooleanLiteralContext{}))
+	l.currentKeyspace.DurableWrites = value.(*parser.BooleanLiteralContext).K_TRUE() != nil
+}
+
+// unquoteString returns the value of a single-quoted string literal.
+func unquoteString(literal string) string {
+	literal = literal[1 : len(literal)-1]
+	var sb strings.Builder
+	for idx := 0; idx < len(literal); idx++ {
+		c := literal[idx]
+		if (c == '\\' || c == '\'') && idx+1 < len(literal) {
+			idx++
+			c = literal[idx]
+		}
+		sb.WriteByte(c)
+	}
+	return sb.String()
+}
@@ -0,0 +1,127 @@
+package schema
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestCreateKeyspace(t *testing.T) {
+	schema, err := ParseString(`-- keyspace comment
+CREATE KEYSPACE sp WITH replication = {'class': 'NetworkTopologyStrategy', 'dc1': 3, 'dc2': '2'}
+	AND durable_writes = false;
+CREATE TABLE sp.tbl (col1 text);
+CREATE TYPE sp.typ (col1 text);
+`)
+	require.NoError(t, err)
+	require.NotNil(t, schema)
+	require.Equal(t, 1, len(schema.Keyspaces))
+	keyspace := schema.GetKeyspace("sp")
+	require.NotNil(t, keyspace)
+	assert.Equal(t, "keyspace comment", keyspace.Comment)
+	assert.Equal(t, "NetworkTopologyStrategy", keyspace.ReplicationClass)
+	assert.Equal(t, map[string]string{"dc1": "3", "dc2": "2"}, keyspace.ReplicationOptions)
+	assert.False(t, keyspace.DurableWrites)
+	require.Equal(t, 1, len(keyspace.Tables))
+	assert.Equal(t, schema.GetTable("sp", "tbl"), keyspace.Tables[0])
+	require.Equal(t, 1, len(keyspace.Types))
+	assert.Equal(t, schema.GetType("sp", "typ"), keyspace.Types[0])
+}
+
+func TestCreateKeyspaceDefaults(t *testing.T) {
+	schema, err := ParseString(`CREATE KEYSPACE sp WITH replication = {'class': 'SimpleStrategy', 'replication_factor': 1};`)
+	require.NoError(t, err)
+	require.NotNil(t, schema)
+	keyspace := schema.GetKeyspace("sp")
+	require.NotNil(t, keyspace)
+	assert.Equal(t, "SimpleStrategy", keyspace.ReplicationClass)
+	assert.Equal(t, map[string]string{"replication_factor": "1"}, keyspace.ReplicationOptions)
+	assert.True(t, keyspace.DurableWrites)
+}
+
+func TestImplicitKeyspace(t *testing.T) {
+	schema, err := ParseString(`CREATE TABLE ab.tbl (col1 text);
+CREATE TABLE sp.tbl (col1 text);
+CREATE KEYSPACE sp WITH replication = {'class': 'SimpleStrategy', 'replication_factor': 1};
+`)
+	require.NoError(t, err)
+	require.NotNil(t, schema)
+	require.Equal(t, 2, len(schema.Keyspaces))
+	assert.Equal(t, "ab", schema.Keyspaces[0].Name)
+	assert.Equal(t, "", schema.Keyspaces[0].ReplicationClass)
+	assert.Equal(t, "sp", schema.Keyspaces[1].Name)
+	assert.Equal(t, "SimpleStrategy", schema.Keyspaces[1].ReplicationClass)
+	assert.NotNil(t, schema.GetTable("sp", "tbl"))
+}
+
+func TestAlterKeyspace(t *testing.T) {
+	schema, err := ParseString(`CREATE KEYSPACE sp WITH replication = {'class': 'SimpleStrategy', 'replication_factor': 1};
+ALTER KEYSPACE sp WITH replication = {'class': 'NetworkTopologyStrategy', 'dc1': 3} AND durable_writes = false;
+`)
+	require.NoError(t, err)
+	require.NotNil(t, schema)
+	keyspace := schema.GetKeyspace("sp")
+	require.NotNil(t, keyspace)
+	assert.Equal(t, "NetworkTopologyStrategy", keyspace.ReplicationClass)
+	assert.Equal(t, map[string]string{"dc1": "3"}, keyspace.ReplicationOptions)
+	assert.False(t, keyspace.DurableWrites)
+}
+
+func TestAlterKeyspaceNonExisting(t *testing.T) {
+	schema, err := ParseString(`CREATE TABLE sp.tbl (col1 text);
+ALTER KEYSPACE sp WITH replication = {'class': 'SimpleStrategy', 'replication_factor': 1};
+`)
+	require.Error(t, err)
+	require.Nil(t, schema)
+}
+
+func TestUnquoteString(t *testing.T) {
+	assert.Equal(t, "", unquoteString(`''`))
+	assert.Equal(t, "abc", unquoteString(`'abc'`))
+	assert.Equal(t, "it's", unquoteString(`'it''s'`))
+}
+
+func TestDropKeyspace(t *testing.T) {
+	schema, err := ParseString(`CREATE KEYSPACE sp WITH replication = {'class': 'SimpleStrategy', 'replication_factor': 1};
+CREATE TABLE sp.tbl (col1 text);
+CREATE TABLE ab.tbl (col1 text);
+DROP KEYSPACE sp;
+DROP KEYSPACE IF EXISTS sp;
+`)
+	require.NoError(t, err)
+	require.NotNil(t, schema)
+	require.Equal(t, 1, len(schema.Keyspaces))
+	assert.Nil(t, schema.GetKeyspace("sp"))
+	assert.Nil(t, schema.GetTable("sp", "tbl"))
+	assert.NotNil(t, schema.GetTable("ab", "tbl"))
+}
+
+func TestDropKeyspaceNonExisting(t *testing.T) {
+	schema, err := ParseString(`DROP KEYSPACE sp;`)
+	require.Error(t, err)
+	require.Nil(t, schema)
+}
+
+func TestCreateKeyspaceDuplicate(t *testing.T) {
+	schema, err := ParseString(`CREATE KEYSPACE sp WITH replication = {'class': 'SimpleStrategy', 'replication_factor': 1};
+CREATE KEYSPACE sp WITH replication = {'class': 'SimpleStrategy', 'replication_factor': 2};
+`)
+	require.Error(t, err)
+	require.Nil(t, schema)
+}
+
+func TestCreateKeyspaceIfNotExists(t *testing.T) {
+	schema, err := ParseString(`-- original
+CREATE KEYSPACE sp WITH replication = {'class': 'SimpleStrategy', 'replication_factor': 1};
+CREATE KEYSPACE IF NOT EXISTS sp WITH replication = {'class': 'SimpleStrategy', 'replication_factor': 2}
+	AND durable_writes = false;
+`)
+	require.NoError(t, err)
+	require.NotNil(t, schema)
+	require.Equal(t, 1, len(schema.Keyspaces))
+	keyspace := schema.GetKeyspace("sp")
+	require.NotNil(t, keyspace)
+	assert.Equal(t, "original", keyspace.Comment)
+	assert.Equal(t, map[string]string{"replication_factor": "1"}, keyspace.ReplicationOptions)
+	assert.True(t, keyspace.DurableWrites)
+}
@@ -0,0 +1,263 @@
+package schema
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Link is a reference from a documentation comment to another schema element.
+//
+// Links are written as [[target]] in the comment text or given by @see tags.
+// The target is a dot-separated name of a keyspace, a table, a materialized view, a user-defined type,
+// a column or a field, for example [[ks.users.id]], [[users.id]] or [[users]].
+// Names without a keyspace are looked up in the keyspace of the commented element.
+type Link struct {
+	// Target is the name of the referenced element as written in the comment.
+	Target string
+	// Reference is the resolved element, set by Schema.ResolveLinks.
+	// Nil if the link was not resolved or the element does not exist.
+	Reference *Reference
+}
+
+// Reference identifies a schema element.
+type Reference struct {
+	Kind ReferenceKind
+	Keyspace string
+	// Object is the name of the table, the materialized view or the user-defined type.
+	// Empty for keyspaces.
+	Object string
+	// Column is the name of the column or the field of the Object.
+	// Empty if the reference is to the whole Object.
+	Column string
+}
+
+// ReferenceKind is the kind of the object a Reference points to.
+type ReferenceKind int
+
+const (
+	// ReferenceKindKeyspace is a reference to a keyspace.
+	ReferenceKindKeyspace ReferenceKind = iota
+	// ReferenceKindTable is a reference to a table or its column.
+	ReferenceKindTable
+	// ReferenceKindView is a reference to a materialized view or its column.
+	ReferenceKindView
+	// ReferenceKindType is a reference to a user-defined type or its field.
+	ReferenceKindType
+)
+
+func (k ReferenceKind) String() string {
+	switch k {
+	case ReferenceKindKeyspace:
+		return "keyspace"
+	case ReferenceKindTable:
+		return "table"
+	case ReferenceKindView:
+		return "view"
+	case ReferenceKindType:
+		return "type"
+	default:
+		return fmt.Sprintf("ReferenceKind(%d)", int(k))
+	}
+}
+
+func (k ReferenceKind) MarshalText() (text []byte, err error) {
+	return []byte(k.String()), nil
+}
+
+// Warning is a problem in the schema documentation that does not prevent using the schema,
+// for example a link to a column that was renamed.
+type Warning struct {
+	// Position is the location of the element with the comment.
+	Position Position
+	// Keyspace, Object and Column identify the element with the comment.
+	Keyspace string
+	Object string
+	Column string
+	// Target is the link target that could not be resolved.
+	Target string
+	Message string
+}
+
+func (w *Warning) String() string {
+	var names []string
+	for _, name := range []string{w.Keyspace, w.Object, w.Column} {
+		if name != "" {
+			names = append(names, name)
+		}
+	}
+	if len(names) == 0 {
+		return fmt.Sprintf("%s: %s: %s", w.Position, w.Message, w.Target)
+	}
+	return fmt.Sprintf("%s: %s: %s in comment of %s", w.Position, w.Message, w.Target, strings.Join(names, "."))
+}
+
+// linkTarget matches a dot-separated name with at most three parts.
+const linkTarget = `[A-Za-z0-9_]+(?:\.[A-Za-z0-9_]+){0,2}`
+
+var linkRegexp = regexp.MustCompile(`\[\[(` + linkTarget + `)\]\]`)
+
+var seeTargetRegexp = regexp.MustCompile(`^` + linkTarget + `$`)
+
+// parseLinks returns links of the comment text and the @see tags.
+// @see tags with other values than names of schema elements, for example URLs, are not links.
+func parseLinks(text string, see []string) []*Link {
+	var ret []*Link
+	seen := make(map[string]bool)
+	add := func(target string) {
+		if !seen[target] {
+			seen[target] = true
+			ret = append(ret, &Link{Target: target})
+		}
+	}
+	for _, match := range linkRegexp.FindAllStringSubmatch(text, -1) {
+		add(match[1])
+	}
+	for _, value := range see {
+		if seeTargetRegexp.MatchString(value) {
+			add(value)
+		}
+	}
+	return ret
+}
+
+// GetLink finds a link by target.
+// Returns nil if not found or if d is nil.
+func (d *Doc) GetLink(target string) *Link {
+	if d == nil {
+		return nil
+	}
+	for _, link := range d.Links {
+		if link.Target == target {
+			return link
+		}
+	}
+	return nil
+}
+
+// ReplaceLinks returns text with every [[target]] replaced by the result of replace.
+// The link passed to replace is nil if d has no link with the target.
+func (d *Doc) ReplaceLinks(text string, replace func(target string, link *Link) string) string {
+	return linkRegexp.ReplaceAllStringFunc(text, func(match string) string {
+		target := match[2 : len(match)-2]
+		return replace(target, d.GetLink(target))
+	})
+}
+
+// Resolve finds the element with the dot-separated name target.
+// The keyspace and object are the context used for names without a keyspace:
+// a single name is an object in the keyspace, a column or a field of the object, or a keyspace.
+// Returns nil if the element does not exist.
+func (s *Schema) Resolve(target, keyspace, object string) *Reference {
+	parts := strings.Split(target, ".")
+	switch len(parts) {
+	case 1:
+		if ref := s.resolveObject(keyspace, parts[0]); ref != nil {
+			return ref
+		}
+		if object != "" {
+			if ref := s.resolveColumn(keyspace, object, parts[0]); ref != nil {
+				return ref
+			}
+		}
+		if s.GetKeyspace(parts[0]) != nil {
+			return &Reference{Kind: ReferenceKindKeyspace, Keyspace: parts[0]}
+		}
+	case 2:
+		if ref := s.resolveObject(parts[0], parts[1]); ref != nil {
+			return ref
+		}
+		return s.resolveColumn(keyspace, parts[0], parts[1])
+	case 3:
+		return s.resolveColumn(parts[0], parts[1], parts[2])
+	}
+	return nil
+}
+
+// resolveObject finds a table, a materialized view or a user-defined type.
+func (s *Schema) resolveObject(keyspace, name string) *Reference {
+	switch {
+	case s.GetTable(keyspace, name) != nil:
+		return &Reference{Kind: ReferenceKindTable, Keyspace: keyspace, Object: name}
+	case s.GetView(keyspace, name) != nil:
+		return &Reference{Kind: ReferenceKindView, Keyspace: keyspace, Object: name}
+	case s.GetType(keyspace, name) != nil:
+		return &Reference{Kind: ReferenceKindType, Keyspace: keyspace, Object: name}
+	}
+	return nil
+}
+
+// resolveColumn finds a column of a table or a materialized view, or a field of a user-defined type.
+func (s *Schema) resolveColumn(keyspace, object, name string) *Reference {
+	ref := s.resolveObject(keyspace, object)
+	if ref == nil {
+		return nil
+	}
+	var found bool
+	switch ref.Kind {
+	case ReferenceKindTable:
+		found = s.GetTable(keyspace, object).GetColumn(name) != nil
+	case ReferenceKindView:
+		found = s.GetView(keyspace, object).GetColumn(name) != nil
+	case ReferenceKindType:
+		found = s.GetType(keyspace, object).GetField(name) != nil
+	}
+	if !found {
+		return nil
+	}
+	ref.Column = name
+	return ref
+}
+
+// ResolveLinks resolves links in documentation comments against the schema
+// and returns warnings about links to elements that do not exist.
+//
+// The schema must be parsed with WithDocComments, comments without Doc have no links.
+// Call ResolveLinks after all statements are applied, so that links to elements created
+// by later statements resolve and links to renamed or dropped elements are reported.
+func (s *Schema) ResolveLinks() []*Warning {
+	var warnings []*Warning
+	resolve := func(doc *Doc, position Position, keyspace, object, column string) {
+		if doc == nil {
+			return
+		}
+		for _, link := range doc.Links {
+			link.Reference = s.Resolve(link.Target, keyspace, object)
+			if link.Reference == nil {
+				warnings = append(warnings, &Warning{
+					Position: position,
+					Keyspace: keyspace,
+					Object: object,
+					Column: column,
+					Target: link.Target,
+					Message: "Broken link",
+				})
+			}
+		}
+	}
+	for _, keyspace := range s.Keyspaces {
+		resolve(keyspace.Doc, keyspace.Position, keyspace.Name, "", "")
+		for _, table := range keyspace.Tables {
+			resolve(table.Doc, table.Position, table.Keyspace, table.Name, "")
+			for _, column := range table.Columns {
+				resolve(column.Doc, column.Position, table.Keyspace, table.Name, column.Name)
+			}
+			for _, index := range table.Indexes {
+				resolve(index.Doc, index.Position, table.Keyspace, table.Name, index.Column)
+			}
+		}
+		for _, view := range keyspace.Views {
+			resolve(view.Doc, view.Position, view.Keyspace, view.Name, "")
+			for _, column := range view.Columns {
+				resolve(column.Doc, column.Position, view.Keyspace, view.Name, column.Name)
+			}
+		}
+		for _, t := range keyspace.Types {
+			resolve(t.Doc, t.Position, t.Keyspace, t.Name, "")
+			for _, field := range t.Fields {
+				resolve(field.Doc, field.Position, t.Keyspace, t.Name, field.Name)
+			}
+		}
+	}
+	return warnings
+}
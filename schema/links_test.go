@@ -0,0 +1,80 @@
+package schema
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestParseDocLinks(t *testing.T) {
+	doc := ParseDoc("Owner of [[ks.accounts]], see [[id]] and [[ks.accounts]].\n@see sessions.user_id\n@see https://example.com")
+	assert.Equal(t, []*Link{{Target: "ks.accounts"}, {Target: "id"}, {Target: "sessions.user_id"}}, doc.Links)
+}
+
+func TestDocReplaceLinks(t *testing.T) {
+	doc := ParseDoc("See [[users]].")
+	replaced := doc.ReplaceLinks("See [[users]] and [[other]].", func(target string, link *Link) string {
+		if link == nil {
+			return "<" + target + ">"
+		}
+		return "{" + link.Target + "}"
+	})
+	assert.Equal(t, "See {users} and <other>.", replaced)
+}
+
+func TestSchemaResolve(t *testing.T) {
+	s, err := ParseString(`
+CREATE KEYSPACE ks WITH replication = {'class': 'SimpleStrategy', 'replication_factor': 1};
+CREATE TYPE ks.address (city text);
+CREATE TABLE ks.users (id uuid PRIMARY KEY, name text);
+CREATE MATERIALIZED VIEW ks.users_by_name AS SELECT * FROM ks.users
+	WHERE name IS NOT NULL AND id IS NOT NULL PRIMARY KEY (name, id);
+`)
+	require.NoError(t, err)
+
+	assert.Equal(t, &Reference{Kind: ReferenceKindKeyspace, Keyspace: "ks"}, s.Resolve("ks", "", ""))
+	assert.Equal(t, &Reference{Kind: ReferenceKindTable, Keyspace: "ks", Object: "users"}, s.Resolve("ks.users", "", ""))
+	assert.Equal(t, &Reference{Kind: ReferenceKindTable, Keyspace: "ks", Object: "users"}, s.Resolve("users", "ks", ""))
+	assert.Equal(t, &Reference{Kind: ReferenceKindView, Keyspace: "ks", Object: "users_by_name", Column: "id"},
+		s.Resolve("ks.users_by_name.id", "", ""))
+	assert.Equal(t, &Reference{Kind: ReferenceKindTable, Keyspace: "ks", Object: "users", Column: "name"},
+		s.Resolve("users.name", "ks", ""))
+	assert.Equal(t, &Reference{Kind: ReferenceKindTable, Keyspace: "ks", Object: "users", Column: "name"},
+		s.Resolve("name", "ks", "users"))
+	assert.Equal(t, &Reference{Kind: ReferenceKindType, Keyspace: "ks", Object: "address", Column: "city"},
+		s.Resolve("address.city", "ks", ""))
+	assert.Nil(t, s.Resolve("users.email", "ks", ""))
+	assert.Nil(t, s.Resolve("name", "ks", ""))
+	assert.Nil(t, s.Resolve("a.b.c.d", "ks", ""))
+}
+
+func TestSchemaResolveLinks(t *testing.T) {
+	s, err := ParseString(`CREATE TABLE ks.users (
+	id uuid PRIMARY KEY,
+	-- Display name, see [[ks.users.id]].
+	name text
+);
+-- Sessions of [[users]].
+-- @see users.name
+CREATE TABLE ks.sessions (id uuid PRIMARY KEY);
+ALTER TABLE ks.users RENAME id TO user_id;
+`, WithFileName("schema.cql"), WithDocComments())
+	require.NoError(t, err)
+
+	warnings := s.ResolveLinks()
+	assert.Equal(t, []*Warning{{
+		Position: Position{File: "schema.cql", Line: 4, Column: 2, Offset: 86},
+		Keyspace: "ks",
+		Object: "users",
+		Column: "name",
+		Target: "ks.users.id",
+		Message: "Broken link",
+	}}, warnings)
+	assert.Equal(t, "schema.cql:4:2: Broken link: ks.users.id in comment of ks.users.name", warnings[0].String())
+
+	doc := s.GetTable("ks", "sessions").Doc
+	assert.Equal(t, &Reference{Kind: ReferenceKindTable, Keyspace: "ks", Object: "users"}, doc.GetLink("users").Reference)
+	assert.Equal(t, &Reference{Kind: ReferenceKindTable, Keyspace: "ks", Object: "users", Column: "name"},
+		doc.GetLink("users.name").Reference)
+	assert.Nil(t, s.GetTable("ks", "users").GetColumn("name").Doc.GetLink("ks.users.id").Reference)
+}
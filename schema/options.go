@@ -0,0 +1,175 @@
+package schema
+
+import (
+	"fmt"
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+	"github.com/martin-sucha/cqldoc/parser"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// Options are the properties set in the WITH clause of a table or a materialized view.
+type Options struct {
+	// Values contains options with a single value, indexed by option name.
+	// String literals are unquoted.
+	Values map[string]string
+	// Maps contains options with a map value, for example compaction, indexed by option name.
+	Maps map[string]map[string]string
+}
+
+// optionKind is the expected value of a well-known option.
+type optionKind int
+
+const (
+	optionString optionKind = iota
+	optionInt
+	optionFloat
+	optionMap
+	// optionMapOrString is a map option that also accepts a single value, for example the legacy form of caching.
+	optionMapOrString
+)
+
+// knownOptions are the options with typed accessors.
+var knownOptions = map[string]optionKind{
+	"comment": optionString,
+	"default_time_to_live": optionInt,
+	"gc_grace_seconds": optionInt,
+	"speculative_retry": optionString,
+	"bloom_filter_fp_chance": optionFloat,
+	"compaction": optionMap,
+	"compression": optionMap,
+	"caching": optionMapOrString,
+}
+
+// Comment returns the comment option.
+func (o *Options) Comment() (string, bool) {
+	value, ok := o.Values["comment"]
+	return value, ok
+}
+
+// DefaultTimeToLive returns the default_time_to_live option in seconds.
+func (o *Options) DefaultTimeToLive() (int, bool) {
+	return o.intValue("default_time_to_live")
+}
+
+// GCGraceSeconds returns the gc_grace_seconds option.
+func (o *Options) GCGraceSeconds() (int, bool) {
+	return o.intValue("gc_grace_seconds")
+}
+
+// SpeculativeRetry returns the speculative_retry option, for example 99PERCENTILE.
+func (o *Options) SpeculativeRetry() (string, bool) {
+	value, ok := o.Values["speculative_retry"]
+	return value, ok
+}
+
+// BloomFilterFPChance returns the bloom_filter_fp_chance option.
+func (o *Options) BloomFilterFPChance() (float64, bool) {
+	value, ok := o.Values["bloom_filter_fp_chance"]
+	if !ok {
+		return 0, false
+	}
+	f, err := strconv.ParseFloat(value, 64)
+	return f, err == nil
+}
+
+// Compaction returns the compaction option.
+// Returns nil if not set.
+func (o *Options) Compaction() map[string]string {
+	return o.Maps["compaction"]
+}
+
+// Compression returns the compression option.
+// Returns nil if not set.
+func (o *Options) Compression() map[string]string {
+	return o.Maps["compression"]
+}
+
+// Caching returns the caching option.
+// Returns nil if not set or if set to a single value like 'KEYS_ONLY' used by old Cassandra versions,
+// the single value is in Values.
+func (o *Options) Caching() map[string]string {
+	return o.Maps["caching"]
+}
+
+func (o *Options) intValue(name string) (int, bool) {
+	value, ok := o.Values[name]
+	if !ok {
+		return 0, false
+	}
+	i, err := strconv.Atoi(value)
+	return i, err == nil
+}
+
+// setValue sets an option with a single value.
+func (o *Options) setValue(name, value string) {
+	if o.Values == nil {
+		o.Values = make(map[string]string)
+	}
+	delete(o.Maps, name)
+	o.Values[name] = value
+}
+
+// setMap sets an option with a map value.
+// The new map replaces any previous value of the option.
+func (o *Options) setMap(name string, value map[string]string) {
+	if o.Maps == nil {
+		o.Maps = make(map[string]map[string]string)
+	}
+	delete(o.Values, name)
+	o.Maps[name] = value
+}
+
+func (l *documentParser) EnterTableOptionItem(ctx *parser.TableOptionItemContext) {
+	if l.currentOptions == nil {
+		return
+	}
+	name := strings.ToLower(ctx.GetChildOfType(0, reflect.TypeOf(&parser.TableOptionNameContext{})).GetText())
+	kind, known := knownOptions[name]
+	if value := ctx.GetChildOfType(0, reflect.TypeOf(&parser.TableOptionValueContext{})); value != nil {
+		literal := getLiteralValue(value.GetChild(0))
+		if known && !validOptionValue(kind, literal) {
+			panic(&SemanticError{Kind: ErrorKindInvalidOption, Message: fmt.Sprintf("Invalid value of option %s: %s", name, literal)})
+		}
+		l.currentOptions.setValue(name, literal)
+		return
+	}
+	if known && kind != optionMap && kind != optionMapOrString {
+		panic(&SemanticError{Kind: ErrorKindInvalidOption, Message: fmt.Sprintf("Option %s must not be a map", name)})
+	}
+	hash := ctx.GetChildOfType(0, reflect.TypeOf(&parser.OptionHashContext{}))
+	values := make(map[string]string)
+	for _, child := range hash.GetChildren() {
+		item, ok := child.(*parser.OptionHashItemContext)
+		if !ok {
+			continue
+		}
+		key := item.GetChildOfType(0, reflect.TypeOf(&parser.OptionHashKeyContext{}))
+		value := item.GetChildOfType(0, reflect.TypeOf(&parser.OptionHashValueContext{}))
+		values[getLiteralValue(key.GetChild(0))] = getLiteralValue(value.GetChild(0))
+	}
+	l.currentOptions.setMap(name, values)
+}
+
+// validOptionValue returns true if the single value is valid for a well-known option of the kind.
+func validOptionValue(kind optionKind, value string) bool {
+	var err error
+	switch kind {
+	case optionInt:
+		_, err = strconv.Atoi(value)
+	case optionFloat:
+		_, err = strconv.ParseFloat(value, 64)
+	case optionMap:
+		return false
+	}
+	return err == nil
+}
+
+// getLiteralValue returns the value of a string or a number literal.
+func getLiteralValue(literal antlr.Tree) string {
+	if stringLiteral, ok := literal.(*parser.StringLiteralContext); ok {
+		return unquoteString(stringLiteral.GetText())
+	}
+	return literal.(antlr.ParseTree).GetText()
+}
@@ -0,0 +1,178 @@
+package schema
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestTableOptions(t *testing.T) {
+	schema, err := ParseString(`CREATE TABLE ab.tbl (col1 text, col2 int, PRIMARY KEY (col1, col2))
+	WITH comment = 'table comment'
+	AND default_time_to_live = 3600
+	AND gc_grace_seconds = 86400
+	AND CLUSTERING ORDER BY (col2 DESC)
+	AND bloom_filter_fp_chance = 0.01
+	AND speculative_retry = '99PERCENTILE'
+	AND caching = {'keys': 'ALL', 'rows_per_partition': 'NONE'}
+	AND compaction = {'class': 'LeveledCompactionStrategy', 'sstable_size_in_mb': 160}
+	AND compression = {'class': 'LZ4Compressor'}
+	AND Custom_Option = 'abc';
+`)
+	require.NoError(t, err)
+	require.NotNil(t, schema)
+	table := schema.GetTable("ab", "tbl")
+	require.NotNil(t, table)
+	assert.Equal(t, ClusteringOrderDesc, table.GetColumn("col2").ClusteringOrder)
+
+	options := table.Options
+	comment, ok := options.Comment()
+	assert.True(t, ok)
+	assert.Equal(t, "table comment", comment)
+	ttl, ok := options.DefaultTimeToLive()
+	assert.True(t, ok)
+	assert.Equal(t, 3600, ttl)
+	gcGrace, ok := options.GCGraceSeconds()
+	assert.True(t, ok)
+	assert.Equal(t, 86400, gcGrace)
+	fpChance, ok := options.BloomFilterFPChance()
+	assert.True(t, ok)
+	assert.Equal(t, 0.01, fpChance)
+	retry, ok := options.SpeculativeRetry()
+	assert.True(t, ok)
+	assert.Equal(t, "99PERCENTILE", retry)
+	assert.Equal(t, map[string]string{"keys": "ALL", "rows_per_partition": "NONE"}, options.Caching())
+	assert.Equal(t, map[string]string{
+		"class": "LeveledCompactionStrategy",
+		"sstable_size_in_mb": "160",
+	}, options.Compaction())
+	assert.Equal(t, map[string]string{"class": "LZ4Compressor"}, options.Compression())
+	assert.Equal(t, "abc", options.Values["custom_option"])
+}
+
+func TestTableOptionsUnset(t *testing.T) {
+	schema, err := ParseString(`CREATE TABLE ab.tbl (col1 text PRIMARY KEY);`)
+	require.NoError(t, err)
+	require.NotNil(t, schema)
+	options := schema.GetTable("ab", "tbl").Options
+	_, ok := options.DefaultTimeToLive()
+	assert.False(t, ok)
+	_, ok = options.GCGraceSeconds()
+	assert.False(t, ok)
+	_, ok = options.BloomFilterFPChance()
+	assert.False(t, ok)
+	assert.Nil(t, options.Compaction())
+}
+
+func TestTableOptionsClusteringOrderFirst(t *testing.T) {
+	schema, err := ParseString(`CREATE TABLE ab.tbl (col1 text, col2 int, PRIMARY KEY (col1, col2))
+	WITH CLUSTERING ORDER BY (col2 DESC) AND gc_grace_seconds = 10;
+`)
+	require.NoError(t, err)
+	require.NotNil(t, schema)
+	table := schema.GetTable("ab", "tbl")
+	require.NotNil(t, table)
+	assert.Equal(t, ClusteringOrderDesc, table.GetColumn("col2").ClusteringOrder)
+	assert.Equal(t, map[string]string{"gc_grace_seconds": "10"}, table.Options.Values)
+}
+
+func TestAlterTableWith(t *testing.T) {
+	schema, err := ParseString(`CREATE TABLE ab.tbl (col1 text PRIMARY KEY)
+	WITH gc_grace_seconds = 10
+	AND default_time_to_live = 60
+	AND compaction = {'class': 'LeveledCompactionStrategy', 'sstable_size_in_mb': 160};
+ALTER TABLE ab.tbl WITH gc_grace_seconds = 20 AND compaction = {'class': 'SizeTieredCompactionStrategy'};
+`)
+	require.NoError(t, err)
+	require.NotNil(t, schema)
+	options := schema.GetTable("ab", "tbl").Options
+	assert.Equal(t, map[string]string{
+		"gc_grace_seconds": "20",
+		"default_time_to_live": "60",
+	}, options.Values)
+	assert.Equal(t, map[string]string{"class": "SizeTieredCompactionStrategy"}, options.Compaction())
+}
+
+func TestTableOptionsInvalidValue(t *testing.T) {
+	for _, option := range []string{
+		"gc_grace_seconds = 'abc'",
+		"default_time_to_live = 1.5",
+		"bloom_filter_fp_chance = 'abc'",
+		"compaction = 'abc'",
+		"comment = {'a': 'b'}",
+	} {
+		schema, err := ParseString(`CREATE TABLE ab.tbl (col1 text PRIMARY KEY) WITH ` + option + `;`)
+		assert.Error(t, err, option)
+		assert.Nil(t, schema, option)
+	}
+}
+
+func TestTableOptionsLegacyCaching(t *testing.T) {
+	schema, err := ParseString(`CREATE TABLE ab.tbl (col1 text PRIMARY KEY) WITH caching = 'KEYS_ONLY';`)
+	require.NoError(t, err)
+	table := schema.GetTable("ab", "tbl")
+	require.NotNil(t, table)
+	assert.Equal(t, "KEYS_ONLY", table.Options.Values["caching"])
+	assert.Nil(t, table.Options.Caching())
+
+	schema, err = ParseString(`CREATE TABLE ab.tbl (col1 text PRIMARY KEY) WITH caching = 'KEYS_ONLY';
+ALTER TABLE ab.tbl WITH caching = {'keys': 'ALL', 'rows_per_partition': 'NONE'};`)
+	require.NoError(t, err)
+	table = schema.GetTable("ab", "tbl")
+	assert.Equal(t, map[string]string{"keys": "ALL", "rows_per_partition": "NONE"}, table.Options.Caching())
+	assert.NotContains(t, table.Options.Values, "caching")
+}
+
+func TestCommentPolicy(t *testing.T) {
+	cql := `-- leading comment
+CREATE TABLE ab.both (col1 text PRIMARY KEY) WITH comment = 'option comment';
+-- leading only
+CREATE TABLE ab.leading (col1 text PRIMARY KEY);
+CREATE TABLE ab.option (col1 text PRIMARY KEY) WITH comment = 'option only';
+`
+	tests := []struct {
+		policy CommentPolicy
+		both string
+	}{
+		{CommentPreferLeading, "leading comment"},
+		{CommentPreferOption, "option comment"},
+		{CommentConcatenate, "leading comment\n\noption comment"},
+	}
+	for _, test := range tests {
+		schema, err := ParseString(cql, WithCommentPolicy(test.policy))
+		require.NoError(t, err)
+		require.NotNil(t, schema)
+		assert.Equal(t, test.both, schema.GetTable("ab", "both").Comment)
+		assert.Equal(t, "leading only", schema.GetTable("ab", "leading").Comment)
+		assert.Equal(t, "option only", schema.GetTable("ab", "option").Comment)
+	}
+}
+
+func TestCommentPolicyDefault(t *testing.T) {
+	schema, err := ParseString(`-- leading comment
+CREATE TABLE ab.tbl (col1 text PRIMARY KEY) WITH comment = 'option comment';
+`)
+	require.NoError(t, err)
+	require.NotNil(t, schema)
+	assert.Equal(t, "leading comment", schema.GetTable("ab", "tbl").Comment)
+}
+
+func TestCommentPolicyAlter(t *testing.T) {
+	schema, err := ParseString(`-- leading comment
+CREATE TABLE ab.tbl (col1 text PRIMARY KEY) WITH comment = 'old comment';
+CREATE MATERIALIZED VIEW ab.v AS SELECT * FROM ab.tbl WHERE col1 IS NOT NULL PRIMARY KEY (col1);
+ALTER TABLE ab.tbl WITH comment = 'new comment';
+ALTER MATERIALIZED VIEW ab.v WITH comment = 'view comment';
+`, WithCommentPolicy(CommentPreferOption))
+	require.NoError(t, err)
+	require.NotNil(t, schema)
+	assert.Equal(t, "new comment", schema.GetTable("ab", "tbl").Comment)
+	assert.Equal(t, "view comment", schema.GetView("ab", "v").Comment)
+}
+
+func TestMergeComment(t *testing.T) {
+	options := Options{}
+	options.setValue("comment", "same")
+	assert.Equal(t, "same", mergeComment(CommentConcatenate, "same", &options))
+	assert.Equal(t, "", mergeComment(CommentConcatenate, "", &Options{}))
+}
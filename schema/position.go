@@ -0,0 +1,63 @@
+package schema
+
+import (
+	"fmt"
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+	"unicode/utf8"
+)
+
+// Position is a location in the parsed source.
+type Position struct {
+	// File is the name of the parsed file, if known.
+	File string
+	// Line is the line number, starting at 1.
+	Line int
+	// Column is the column number in characters, starting at 1.
+	Column int
+	// Offset is the byte offset from the beginning of the file, starting at 0.
+	Offset int
+}
+
+// IsValid returns true if the position was set.
+func (p Position) IsValid() bool {
+	return p.Line > 0
+}
+
+// String returns the position in the form file:line:column.
+// The file is omitted if not known.
+func (p Position) String() string {
+	s := fmt.Sprintf("%d:%d", p.Line, p.Column)
+	if p.File != "" {
+		s = p.File + ":" + s
+	}
+	return s
+}
+
+// position returns the position of the token.
+func (l *documentParser) position(token antlr.Token) Position {
+	return l.positionAt(token.GetLine(), token.GetColumn(), token.GetStart())
+}
+
+// positionAt returns the position of the character at charIndex, which is on the line and 0-based column.
+func (l *documentParser) positionAt(line, column, charIndex int) Position {
+	return Position{
+		File: l.fileName,
+		Line: line,
+		Column: column + 1,
+		Offset: l.byteOffset(charIndex),
+	}
+}
+
+// byteOffset converts an index of a character in the input to a byte offset.
+// Consecutive calls are cheap if the indexes increase, which is the order in which the tree is walked.
+func (l *documentParser) byteOffset(charIndex int) int {
+	if charIndex < l.offsetChar {
+		l.offsetChar, l.offsetByte = 0, 0
+	}
+	for l.offsetChar < charIndex && l.offsetByte < len(l.input) {
+		_, size := utf8.DecodeRuneInString(l.input[l.offsetByte:])
+		l.offsetByte += size
+		l.offsetChar++
+	}
+	return l.offsetByte
+}
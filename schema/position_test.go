@@ -0,0 +1,67 @@
+package schema
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestPositions(t *testing.T) {
+	schema, err := ParseString(`-- Ünicode comment
+CREATE KEYSPACE sp WITH replication = {'class': 'SimpleStrategy', 'replication_factor': 1};
+CREATE TABLE sp.tbl (
+	col1 text PRIMARY KEY,
+	col2 text
+);
+  ALTER TABLE sp.tbl ADD col3 int;
+ALTER TABLE sp.tbl RENAME col1 TO id;
+CREATE TYPE sp.typ (f1 text);
+ALTER TYPE sp.typ RENAME f1 TO f2;
+ALTER KEYSPACE sp WITH replication = {'class': 'SimpleStrategy', 'replication_factor': 2};
+CREATE INDEX ON sp.tbl (col2);
+`, WithFileName("schema.cql"))
+	require.NoError(t, err)
+	require.NotNil(t, schema)
+
+	keyspace := schema.GetKeyspace("sp")
+	require.NotNil(t, keyspace)
+	assert.Equal(t, Position{File: "schema.cql", Line: 2, Column: 1, Offset: 20}, keyspace.Position)
+	assert.Equal(t, []Position{{File: "schema.cql", Line: 11, Column: 1, Offset: 310}}, keyspace.Alterations)
+
+	table := schema.GetTable("sp", "tbl")
+	require.NotNil(t, table)
+	assert.Equal(t, Position{File: "schema.cql", Line: 3, Column: 1, Offset: 112}, table.Position)
+	assert.Equal(t, []Position{
+		{File: "schema.cql", Line: 7, Column: 3, Offset: 174},
+		{File: "schema.cql", Line: 8, Column: 1, Offset: 207},
+	}, table.Alterations)
+
+	id := table.GetColumn("id")
+	require.NotNil(t, id)
+	assert.Equal(t, Position{File: "schema.cql", Line: 4, Column: 2, Offset: 135}, id.Position)
+	assert.Equal(t, []Position{{File: "schema.cql", Line: 8, Column: 1, Offset: 207}}, id.Alterations)
+	col3 := table.GetColumn("col3")
+	require.NotNil(t, col3)
+	assert.Equal(t, Position{File: "schema.cql", Line: 7, Column: 26, Offset: 197}, col3.Position)
+	assert.Empty(t, col3.Alterations)
+
+	typ := schema.GetType("sp", "typ")
+	require.NotNil(t, typ)
+	assert.Equal(t, 9, typ.Position.Line)
+	assert.Equal(t, []Position{{File: "schema.cql", Line: 10, Column: 1, Offset: 275}}, typ.Alterations)
+	field := typ.GetField("f2")
+	require.NotNil(t, field)
+	assert.Equal(t, Position{File: "schema.cql", Line: 9, Column: 21, Offset: 265}, field.Position)
+	assert.Equal(t, typ.Alterations, field.Alterations)
+
+	require.Equal(t, 1, len(table.Indexes))
+	assert.Equal(t, 12, table.Indexes[0].Position.Line)
+	assert.Equal(t, "schema.cql:12:1", table.Indexes[0].Position.String())
+}
+
+func TestPositionString(t *testing.T) {
+	assert.Equal(t, "a.cql:1:2", Position{File: "a.cql", Line: 1, Column: 2}.String())
+	assert.Equal(t, "1:2", Position{Line: 1, Column: 2}.String())
+	assert.False(t, Position{}.IsValid())
+	assert.True(t, Position{Line: 1, Column: 1}.IsValid())
+}
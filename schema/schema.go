@@ -3,53 +3,167 @@ package schema
 
 import (
 	"bytes"
+	"fmt"
 	"github.com/antlr/antlr4/runtime/Go/antlr"
 	"github.com/martin-sucha/cqldoc/parser"
 	"io"
 	"io/ioutil"
 	"reflect"
+	"sort"
 )
 
 type Schema struct {
-	Tables []*Table
+	Keyspaces []*Keyspace
 }
 
 type Table struct {
 	Comment string
+	// Doc is the parsed Comment, set if the schema was parsed with WithDocComments.
+	Doc *Doc
 	Keyspace string
 	Name string
 	Columns []*Column
+	// Views are names of materialized views that select from the table.
+	Views []string
+	Indexes []*Index
+	Options Options
+	// Position is the location of the statement that created the table.
+	Position Position
+	// Alterations are locations of ALTER statements that changed the table.
+	Alterations []Position
+	// leadingComment is the comment preceding the CREATE statement.
+	leadingComment string
 }
 
 type Column struct {
 	Comment string
+	// Doc is the parsed Comment, set if the schema was parsed with WithDocComments.
+	Doc *Doc
 	Name string
+	// CqlType is the data type in the canonical form, see DataType.String.
 	CqlType string
+	// DataType is the structured form of CqlType.
+	DataType *DataType
+	// Kind is the role of the column in the primary key.
+	Kind ColumnKind
+	// KeyIndex is the position of the column within the partition key or the clustering key.
+	// It is zero for regular columns.
+	KeyIndex int
+	// ClusteringOrder is the sort direction of a clustering column.
+	// It is ClusteringOrderNone for columns that are not clustering columns.
+	ClusteringOrder ClusteringOrder
+	// Static is true if the column value is shared by all rows of a partition.
+	Static bool
+	// Position is the location of the column definition.
+	Position Position
+	// Alterations are locations of ALTER statements that changed the column.
+	Alterations []Position
 }
 
-type ParseError struct {
-	Message string
+// ColumnKind describes the role of a column in the table.
+type ColumnKind int
+
+const (
+	// ColumnKindRegular is a column that is not part of the primary key.
+	ColumnKindRegular ColumnKind = iota
+	// ColumnKindPartitionKey is a column that is part of the partition key.
+	ColumnKindPartitionKey
+	// ColumnKindClustering is a column that is part of the clustering key.
+	ColumnKindClustering
+)
+
+func (k ColumnKind) String() string {
+	switch k {
+	case ColumnKindRegular:
+		return "regular"
+	case ColumnKindPartitionKey:
+		return "partition_key"
+	case ColumnKindClustering:
+		return "clustering"
+	default:
+		return fmt.Sprintf("ColumnKind(%d)", int(k))
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (k ColumnKind) MarshalText() ([]byte, error) {
+	return []byte(k.String()), nil
 }
 
-func (pe *ParseError) Error() string {
-	return pe.Message
+// ClusteringOrder is the direction in which rows are sorted by a clustering column.
+type ClusteringOrder int
+
+const (
+	// ClusteringOrderNone is used for columns that are not clustering columns.
+	ClusteringOrderNone ClusteringOrder = iota
+	// ClusteringOrderAsc sorts the rows in ascending order.
+	ClusteringOrderAsc
+	// ClusteringOrderDesc sorts the rows in descending order.
+	ClusteringOrderDesc
+)
+
+func (o ClusteringOrder) String() string {
+	switch o {
+	case ClusteringOrderNone:
+		return "none"
+	case ClusteringOrderAsc:
+		return "asc"
+	case ClusteringOrderDesc:
+		return "desc"
+	default:
+		return fmt.Sprintf("ClusteringOrder(%d)", int(o))
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (o ClusteringOrder) MarshalText() ([]byte, error) {
+	return []byte(o.String()), nil
+}
+
+// IsKey returns true if the column is part of the primary key.
+func (c *Column) IsKey() bool {
+	return c.Kind == ColumnKindPartitionKey || c.Kind == ColumnKindClustering
 }
 
 // GetTable finds a table with the keyspace and name.
 // Returns nil if not found.
 func (s *Schema) GetTable(keyspace, name string) *Table {
-	for _, t := range s.Tables {
-		if t.Keyspace == keyspace && t.Name == name {
-			return t
-		}
+	k := s.GetKeyspace(keyspace)
+	if k == nil {
+		return nil
 	}
-	return nil
+	return k.GetTable(name)
 }
 
 // GetColumn finds a column by name.
 // Returns nil if not found.
 func (s *Table) GetColumn(name string) *Column {
-	for _, column := range s.Columns {
+	return findColumn(s.Columns, name)
+}
+
+// PartitionKey returns the partition key columns in key order.
+func (s *Table) PartitionKey() []*Column {
+	return keyColumns(s.Columns, ColumnKindPartitionKey)
+}
+
+// ClusteringKey returns the clustering columns in key order.
+func (s *Table) ClusteringKey() []*Column {
+	return keyColumns(s.Columns, ColumnKindClustering)
+}
+
+// ColumnIndexes returns secondary indexes of the column.
+func (s *Table) ColumnIndexes(name string) []*Index {
+	var ret []*Index
+	for _, index := range s.Indexes {
+		if index.Column == name {
+			ret = append(ret, index)
+		}
+	}
+	return ret
+}
+
+func findColumn(columns []*Column, name string) *Column {
+	for _, column := range columns {
 		if column.Name == name {
 			return column
 		}
@@ -57,111 +171,331 @@ func (s *Table) GetColumn(name string) *Column {
 	return nil
 }
 
+func keyColumns(columns []*Column, kind ColumnKind) []*Column {
+	var ret []*Column
+	for _, column := range columns {
+		if column.Kind == kind {
+			ret = append(ret, column)
+		}
+	}
+	sort.SliceStable(ret, func(i, j int) bool {
+		return ret[i].KeyIndex < ret[j].KeyIndex
+	})
+	return ret
+}
+
+// addKeyColumn appends an existing column to the partition key or the clustering key.
+func addKeyColumn(columns []*Column, name string, kind ColumnKind) {
+	column := findColumn(columns, name)
+	if column == nil {
+		panic(&SemanticError{Kind: ErrorKindUnknownColumn, Column: name, Message: "Primary key column does not exist"})
+	}
+	if column.IsKey() {
+		panic(&SemanticError{Kind: ErrorKindInvalidPrimaryKey, Column: name, Message: "Duplicate primary key column found"})
+	}
+	column.KeyIndex = len(keyColumns(columns, kind))
+	column.Kind = kind
+	if kind == ColumnKindClustering {
+		column.ClusteringOrder = ClusteringOrderAsc
+	}
+}
+
+// columnOrder is a single item of the CLUSTERING ORDER BY clause.
+type columnOrder struct {
+	name  string
+	order ClusteringOrder
+}
+
+// setClusteringOrder sets the sort direction of clustering columns.
+// The columns must be listed in the same order as in the clustering key.
+func setClusteringOrder(columns []*Column, orders []columnOrder) {
+	for idx, item := range orders {
+		column := findColumn(columns, item.name)
+		if column == nil || column.Kind != ColumnKindClustering {
+			panic(&SemanticError{
+				Kind: ErrorKindInvalidPrimaryKey,
+				Column: item.name,
+				Message: "Clustering order column is not a clustering column",
+			})
+		}
+		if column.KeyIndex != idx {
+			panic(&SemanticError{
+				Kind: ErrorKindInvalidPrimaryKey,
+				Column: item.name,
+				Message: "Clustering order columns must be in the order of the clustering key",
+			})
+		}
+		column.ClusteringOrder = item.order
+	}
+}
+
+// checkStaticColumn panics if the column can't be static in the table.
+func checkStaticColumn(table *Table, column *Column) {
+	if !column.Static {
+		return
+	}
+	if column.IsKey() {
+		panic(&SemanticError{Kind: ErrorKindStaticColumn, Column: column.Name, Message: "Primary key column cannot be static"})
+	}
+	if len(table.ClusteringKey()) == 0 {
+		panic(&SemanticError{
+			Kind: ErrorKindStaticColumn,
+			Column: column.Name,
+			Message: "Static columns are only allowed in tables with clustering columns",
+		})
+	}
+}
+
+// columnError returns a semantic error related to a column of the table.
+func (s *Table) columnError(kind ErrorKind, column, message string) *SemanticError {
+	return &SemanticError{Kind: kind, Keyspace: s.Keyspace, Object: s.Name, Column: column, Message: message}
+}
+
 // DropColumn drops a column.
-// Does nothing if not found.
-func (s *Table) DropColumn(name string) {
+// It returns a *SemanticError if the column does not exist or can't be dropped.
+func (s *Table) DropColumn(name string) error {
 	for idx, column := range s.Columns {
 		if column.Name == name {
+			if column.IsKey() {
+				return s.columnError(ErrorKindKeyColumn, name, "Cannot drop primary key column")
+			}
+			if len(s.ColumnIndexes(name)) > 0 {
+				return s.columnError(ErrorKindIndexedColumn, name, "Cannot drop indexed column")
+			}
 			copy(s.Columns[idx:], s.Columns[idx+1:])
 			s.Columns[len(s.Columns)-1] = nil
 			s.Columns = s.Columns[:len(s.Columns)-1]
-			return
+			return nil
 		}
 	}
+	return s.columnError(ErrorKindUnknownColumn, name, "Column does not exist")
 }
 
 // RenameColumn renames a column.
-func (s *Table) RenameColumn(oldName, newName string) {
+// It returns a *SemanticError if the column does not exist or can't be renamed.
+func (s *Table) RenameColumn(oldName, newName string) error {
 	oldColumn := s.GetColumn(oldName)
 	if oldColumn == nil {
-		panic(&ParseError{"Column does not exist"})
+		return s.columnError(ErrorKindUnknownColumn, oldName, "Column does not exist")
 	}
 	newColumn := s.GetColumn(newName)
 	if newColumn != nil {
-		panic(&ParseError{"Duplicate column found"})
+		return s.columnError(ErrorKindDuplicateColumn, newName, "Duplicate column found")
+	}
+	if len(s.ColumnIndexes(oldName)) > 0 {
+		return s.columnError(ErrorKindIndexedColumn, oldName, "Cannot rename indexed column")
 	}
 	oldColumn.Name = newName
+	return nil
 }
 
-func Parse(r io.Reader) (*Schema, error) {
-	data, err := ioutil.ReadAll(r)
+// ParseOption configures Parse.
+type ParseOption func(*documentParser)
+
+// WithCommentPolicy sets how comments of tables and materialized views are combined with their comment option.
+// The default is CommentPreferLeading.
+func WithCommentPolicy(policy CommentPolicy) ParseOption {
+	return func(l *documentParser) {
+		l.commentPolicy = policy
+	}
+}
+
+// WithDocComments parses comments of schema elements into their Doc fields, see ParseDoc.
+func WithDocComments() ParseOption {
+	return func(l *documentParser) {
+		l.docComments = true
+	}
+}
+
+// WithFileName sets the file name used in positions of schema elements.
+func WithFileName(name string) ParseOption {
+	return func(l *documentParser) {
+		l.fileName = name
+	}
+}
+
+// Parse parses CQL statements from r into a new schema.
+// The returned error is a *ParseError if the statements are not valid.
+func Parse(r io.Reader, opts ...ParseOption) (*Schema, error) {
+	schema := &Schema{}
+	err := schema.Apply(r, opts...)
 	if err != nil {
 		return nil, err
 	}
+	return schema, nil
+}
+
+// Apply parses CQL statements from r and applies them to the existing schema,
+// for example to replay new migrations onto a schema parsed earlier.
+// The keyspace selected by the USE statement does not carry over from previous calls.
+//
+// Statements are applied only if the input has no syntax errors.
+// Statements that can't be applied are reported in the returned *ParseError and have no effect on the schema,
+// not even the parts that were valid. The other statements are applied.
+func (s *Schema) Apply(r io.Reader, opts ...ParseOption) error {
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return err
+	}
 	input := antlr.NewInputStream(string(data))
 	lexer := parser.NewCqlLexer(input)
 	stream := antlr.NewCommonTokenStream(lexer,0)
 	p := parser.NewCqlParser(stream)
-	p.AddErrorListener(antlr.NewDiagnosticErrorListener(true))
-	p.BuildParseTrees = true
-	tree := p.Root()
-	schema := &Schema{}
-
-	err = recoverParseError(func() {
-		antlr.ParseTreeWalkerDefault.Walk(&documentParser{
-			stream: stream,
-			schema: schema,
-		}, tree)
-	})
 
-	if err != nil {
-		return nil, err
+	listener := &documentParser{
+		stream: stream,
+		schema: s,
+		input: string(data),
+	}
+	for _, opt := range opts {
+		opt(listener)
 	}
 
-	return schema, nil
-}
+	errorListener := &syntaxErrorListener{parser: listener}
+	lexer.RemoveErrorListeners()
+	lexer.AddErrorListener(errorListener)
+	p.RemoveErrorListeners()
+	p.AddErrorListener(errorListener)
+	p.BuildParseTrees = true
+	tree := p.Root()
+	if len(errorListener.errors) > 0 {
+		return &ParseError{SyntaxErrors: errorListener.errors}
+	}
 
-func recoverParseError(f func()) (err error) {
-	defer func() {
-		recovered := recover()
-		if recovered == nil {
-			return
-		}
-		if recoveredErr, ok := recovered.(*ParseError); ok {
-			err = recoveredErr
-		} else {
-			panic(recovered)
+	var semanticErrors []*SemanticError
+	if cqls := tree.(*parser.RootContext).Cqls(); cqls != nil {
+		for _, statement := range cqls.(*parser.CqlsContext).AllCql() {
+			if err := listener.applyStatement(statement); err != nil {
+				semanticErrors = append(semanticErrors, err)
+			}
 		}
-	}()
-	f()
+	}
+	if listener.docComments {
+		s.parseDocs()
+	}
+	if len(semanticErrors) > 0 {
+		return &ParseError{SemanticErrors: semanticErrors}
+	}
 	return nil
 }
 
-func ParseString(cql string) (*Schema, error) {
-	return Parse(bytes.NewReader([]byte(cql)))
+func ParseString(cql string, opts ...ParseOption) (*Schema, error) {
+	return Parse(bytes.NewReader([]byte(cql)), opts...)
 }
 
 type documentParser struct {
 	*parser.BaseCqlParserListener
-	stream       *antlr.CommonTokenStream
-	schema       *Schema
-	currentTable *Table
+	stream          *antlr.CommonTokenStream
+	schema          *Schema
+	currentKeyspace *Keyspace
+	currentTable    *Table
+	currentType     *Type
+	currentView     *MaterializedView
+	// currentOptions receives options of the WITH clause being parsed.
+	currentOptions *Options
+	// usedKeyspace is the keyspace selected by the last USE statement.
+	usedKeyspace string
+	// hasPrimaryKey is true if a primary key of currentTable was already defined.
+	hasPrimaryKey bool
+	// commentPolicy selects how leading comments are combined with the comment option.
+	commentPolicy CommentPolicy
+	// docComments is true if comments are parsed into Doc fields.
+	docComments bool
+	// fileName is the name of the parsed file used in positions.
+	fileName string
+	// input is the parsed text.
+	input string
+	// offsetChar and offsetByte cache the last conversion of byteOffset.
+	offsetChar, offsetByte int
+	// statementPosition is the location of the statement being walked.
+	statementPosition Position
+	// snapshot saves the elements changed by the statement being walked.
+	snapshot *snapshot
 }
 
-func (l *documentParser) EnterCreateTable(ctx *parser.CreateTableContext) {
-
-	tokens := l.stream.GetHiddenTokensToLeft(ctx.GetStart().GetTokenIndex(), antlr.TokenHiddenChannel)
-	comment := getComment(tokens)
+// typedChildGetter is implemented by parser rule contexts.
+type typedChildGetter interface {
+	GetChildOfType(i int, childType reflect.Type) antlr.RuleContext
+}
 
+// getQualifiedName returns the keyspace and the name of the object of type nameType defined by ctx.
+// The keyspace selected by the USE statement is returned if ctx does not specify one.
+func (l *documentParser) getQualifiedName(ctx typedChildGetter, nameType reflect.Type) (keyspace, name string) {
 	// keyspace may be nil if not specified
-	keyspace := ctx.GetChildOfType(0, reflect.TypeOf(&parser.KeyspaceContext{}))
-	tableName := ctx.GetChildOfType(0, reflect.TypeOf(&parser.TableContext{}))
+	keyspaceCtx := ctx.GetChildOfType(0, reflect.TypeOf(&parser.KeyspaceContext{}))
+	if keyspaceCtx != nil {
+		keyspace = keyspaceCtx.GetText()
+	} else {
+		keyspace = l.usedKeyspace
+	}
+	return keyspace, ctx.GetChildOfType(0, nameType).GetText()
+}
 
-	var keyspaceText string
-	if keyspace != nil {
-		keyspaceText = keyspace.GetText()
+// skipStatement is raised by a panic to stop walking a statement that has no effect.
+type skipStatement struct{}
+
+// checkCreate stops a CREATE statement if the object already exists.
+// It panics with err if the statement does not specify IF NOT EXISTS.
+// Otherwise, like in Cassandra, the rest of the statement is skipped without validation.
+func checkCreate(exists bool, ifNotExist parser.IIfNotExistContext, err *SemanticError) {
+	if !exists {
+		return
+	}
+	if ifNotExist == nil {
+		panic(err)
 	}
+	panic(skipStatement{})
+}
+
+func (l *documentParser) EnterCql(ctx *parser.CqlContext) {
+	l.statementPosition = l.position(ctx.GetStart())
+}
 
+func (l *documentParser) EnterUse(ctx *parser.UseContext) {
+	l.usedKeyspace = ctx.GetChildOfType(0, reflect.TypeOf(&parser.KeyspaceContext{})).GetText()
+}
+
+func (l *documentParser) EnterCreateTable(ctx *parser.CreateTableContext) {
+
+	tokens := l.stream.GetHiddenTokensToLeft(ctx.GetStart().GetTokenIndex(), antlr.TokenHiddenChannel)
+	comment := getComment(tokens)
+
+	keyspaceName, name := l.getQualifiedName(ctx, reflect.TypeOf(&parser.TableContext{}))
+	exists := l.schema.GetTable(keyspaceName, name) != nil || l.schema.GetView(keyspaceName, name) != nil
+	duplicate := &SemanticError{Kind: ErrorKindDuplicateTable, Keyspace: keyspaceName, Object: name, Message: "Duplicate table found"}
+	checkCreate(exists, ctx.IfNotExist(), duplicate)
 	l.currentTable = &Table{
 		Comment: comment,
-		Keyspace: keyspaceText,
-		Name: tableName.GetText(),
+		leadingComment: comment,
+		Keyspace: keyspaceName,
+		Name: name,
+		Position: l.statementPosition,
 	}
-	l.schema.Tables = append(l.schema.Tables, l.currentTable)
+	l.currentOptions = &l.currentTable.Options
+	keyspace := l.schema.keyspace(keyspaceName)
+	l.snapshot.saveKeyspace(keyspace)
+	keyspace.Tables = append(keyspace.Tables, l.currentTable)
 }
 
 func (l *documentParser) ExitCreateTable(ctx *parser.CreateTableContext) {
+	for _, column := range l.currentTable.Columns {
+		checkStaticColumn(l.currentTable, column)
+	}
+	l.currentTable.Comment = mergeComment(l.commentPolicy, l.currentTable.leadingComment, &l.currentTable.Options)
 	l.currentTable = nil
+	l.currentOptions = nil
+	l.hasPrimaryKey = false
+}
+
+func (l *documentParser) EnterDropTable(ctx *parser.DropTableContext) {
+	keyspaceName, name := l.getQualifiedName(ctx, reflect.TypeOf(&parser.TableContext{}))
+	keyspace := l.schema.GetKeyspace(keyspaceName)
+	if keyspace != nil {
+		l.snapshot.saveKeyspace(keyspace)
+	}
+	if (keyspace == nil || !keyspace.dropTable(name)) && ctx.IfExist() == nil {
+		panic(&SemanticError{Kind: ErrorKindUnknownTable, Keyspace: keyspaceName, Object: name, Message: "Table not found"})
+	}
 }
 
 func (l *documentParser) EnterColumnDefinition(ctx *parser.ColumnDefinitionContext) {
@@ -170,32 +504,40 @@ func (l *documentParser) EnterColumnDefinition(ctx *parser.ColumnDefinitionConte
 
 	columnName := ctx.GetChildOfType(0, reflect.TypeOf(&parser.ColumnContext{}))
 	columnType := ctx.GetChildOfType(0, reflect.TypeOf(&parser.DataTypeContext{}))
+	dataType := parseDataType(columnType.(*parser.DataTypeContext), l.currentTable.Keyspace)
 	column := &Column{
 		Comment: comment,
 		Name: columnName.GetText(),
-		CqlType: columnType.GetText(),
+		CqlType: dataType.String(),
+		DataType: dataType,
+		Static: ctx.KwStatic() != nil,
+		Position: l.position(ctx.GetStart()),
+	}
+	if l.currentTable.GetColumn(column.Name) != nil {
+		panic(l.currentTable.columnError(ErrorKindDuplicateColumn, column.Name, "Duplicate column found"))
 	}
 	l.currentTable.Columns = append(l.currentTable.Columns, column)
 }
 
 func (l *documentParser) EnterAlterTable(ctx *parser.AlterTableContext) {
-	// keyspace may be nil if not specified
-	keyspace := ctx.GetChildOfType(0, reflect.TypeOf(&parser.KeyspaceContext{}))
-	tableName := ctx.GetChildOfType(0, reflect.TypeOf(&parser.TableContext{}))
-
-	var keyspaceText string
-	if keyspace != nil {
-		keyspaceText = keyspace.GetText()
-	}
-
-	l.currentTable = l.schema.GetTable(keyspaceText, tableName.GetText())
+	keyspace, name := l.getQualifiedName(ctx, reflect.TypeOf(&parser.TableContext{}))
+	l.currentTable = l.schema.GetTable(keyspace, name)
 	if l.currentTable == nil {
-		panic(&ParseError{Message: "Table not found"})
+		panic(&SemanticError{Kind: ErrorKindUnknownTable, Keyspace: keyspace, Object: name, Message: "Table not found"})
 	}
+	// Changes of the table columns are propagated to its materialized views.
+	l.snapshot.saveTable(l.currentTable)
+	for _, view := range l.schema.tableViews(l.currentTable) {
+		l.snapshot.saveView(view)
+	}
+	l.currentTable.Alterations = append(l.currentTable.Alterations, l.statementPosition)
+	l.currentOptions = &l.currentTable.Options
 }
 
 func (l *documentParser) ExitAlterTable(ctx *parser.AlterTableContext) {
+	l.currentTable.Comment = mergeComment(l.commentPolicy, l.currentTable.leadingComment, &l.currentTable.Options)
 	l.currentTable = nil
+	l.currentOptions = nil
 }
 
 func (l *documentParser) EnterAlterTableColumnDefinition(ctx *parser.AlterTableColumnDefinitionContext) {
@@ -204,18 +546,37 @@ func (l *documentParser) EnterAlterTableColumnDefinition(ctx *parser.AlterTableC
 
 	columnName := ctx.GetChildOfType(0, reflect.TypeOf(&parser.ColumnContext{}))
 	columnType := ctx.GetChildOfType(0, reflect.TypeOf(&parser.DataTypeContext{}))
+	dataType := parseDataType(columnType.(*parser.DataTypeContext), l.currentTable.Keyspace)
 	column := &Column{
 		Comment: comment,
 		Name: columnName.GetText(),
-		CqlType: columnType.GetText(),
+		CqlType: dataType.String(),
+		DataType: dataType,
+		Static: ctx.KwStatic() != nil,
+		Position: l.position(ctx.GetStart()),
+	}
+	if l.currentTable.GetColumn(column.Name) != nil {
+		panic(l.currentTable.columnError(ErrorKindDuplicateColumn, column.Name, "Duplicate column found"))
 	}
+	checkStaticColumn(l.currentTable, column)
 	l.currentTable.Columns = append(l.currentTable.Columns, column)
+	for _, view := range l.schema.tableViews(l.currentTable) {
+		if view.IncludeAllColumns {
+			view.Columns = append(view.Columns, viewColumn(column))
+			view.Alterations = append(view.Alterations, l.statementPosition)
+		}
+	}
 }
 
 func (l *documentParser) EnterAlterTableDropColumnList(ctx *parser.AlterTableDropColumnListContext) {
+	if len(l.currentTable.Views) > 0 {
+		panic(&SemanticError{Kind: ErrorKindDependentView, Message: "Cannot drop columns from a table with materialized views"})
+	}
 	for _, child := range ctx.GetChildren() {
 		if column, ok := child.(*parser.ColumnContext); ok {
-			l.currentTable.DropColumn(column.GetText())
+			if err := l.currentTable.DropColumn(column.GetText()); err != nil {
+				panic(err)
+			}
 		}
 	}
 }
@@ -223,6 +584,79 @@ func (l *documentParser) EnterAlterTableDropColumnList(ctx *parser.AlterTableDro
 func (l *documentParser) EnterAlterTableRename(ctx *parser.AlterTableRenameContext) {
 	oldName := ctx.GetChildOfType(0, reflect.TypeOf(&parser.ColumnContext{})).GetText()
 	newName := ctx.GetChildOfType(1, reflect.TypeOf(&parser.ColumnContext{})).GetText()
-	l.currentTable.RenameColumn(oldName, newName)
+	if err := l.currentTable.RenameColumn(oldName, newName); err != nil {
+		panic(err)
+	}
+	column := l.currentTable.GetColumn(newName)
+	column.Alterations = append(column.Alterations, l.statementPosition)
+	for _, view := range l.schema.tableViews(l.currentTable) {
+		if column := view.GetColumn(oldName); column != nil {
+			column.Name = newName
+			column.Alterations = append(column.Alterations, l.statementPosition)
+			view.Alterations = append(view.Alterations, l.statementPosition)
+		}
+	}
+}
+
+// currentColumns returns the columns of the table or the materialized view being defined.
+func (l *documentParser) currentColumns() []*Column {
+	if l.currentView != nil {
+		return l.currentView.Columns
+	}
+	return l.currentTable.Columns
+}
+
+// startPrimaryKey ensures the primary key of the current table is defined only once.
+func (l *documentParser) startPrimaryKey() {
+	if l.hasPrimaryKey {
+		panic(&SemanticError{Kind: ErrorKindInvalidPrimaryKey, Message: "Multiple primary keys found"})
+	}
+	l.hasPrimaryKey = true
 }
 
+func (l *documentParser) EnterPrimaryKeyColumn(ctx *parser.PrimaryKeyColumnContext) {
+	l.startPrimaryKey()
+	columnDefinition := ctx.GetParent().(*parser.ColumnDefinitionContext)
+	columnName := columnDefinition.GetChildOfType(0, reflect.TypeOf(&parser.ColumnContext{}))
+	addKeyColumn(l.currentColumns(), columnName.GetText(), ColumnKindPartitionKey)
+}
+
+func (l *documentParser) EnterPrimaryKeyElement(ctx *parser.PrimaryKeyElementContext) {
+	l.startPrimaryKey()
+}
+
+func (l *documentParser) EnterSinglePrimaryKey(ctx *parser.SinglePrimaryKeyContext) {
+	columnName := ctx.GetChildOfType(0, reflect.TypeOf(&parser.ColumnContext{}))
+	addKeyColumn(l.currentColumns(), columnName.GetText(), ColumnKindPartitionKey)
+}
+
+func (l *documentParser) EnterPartitionKey(ctx *parser.PartitionKeyContext) {
+	columnName := ctx.GetChildOfType(0, reflect.TypeOf(&parser.ColumnContext{}))
+	addKeyColumn(l.currentColumns(), columnName.GetText(), ColumnKindPartitionKey)
+}
+
+func (l *documentParser) EnterClusteringKey(ctx *parser.ClusteringKeyContext) {
+	columnName := ctx.GetChildOfType(0, reflect.TypeOf(&parser.ColumnContext{}))
+	addKeyColumn(l.currentColumns(), columnName.GetText(), ColumnKindClustering)
+}
+
+func (l *documentParser) EnterClusteringOrder(ctx *parser.ClusteringOrderContext) {
+	setClusteringOrder(l.currentColumns(), getClusteringOrder(ctx))
+}
+
+// getClusteringOrder returns the columns listed in the clustering order clause.
+// Columns without explicit direction are sorted in ascending order.
+func getClusteringOrder(ctx *parser.ClusteringOrderContext) []columnOrder {
+	var orders []columnOrder
+	for _, child := range ctx.GetChildren() {
+		switch c := child.(type) {
+		case *parser.ColumnContext:
+			orders = append(orders, columnOrder{name: c.GetText(), order: ClusteringOrderAsc})
+		case *parser.OrderDirectionContext:
+			if c.KwDesc() != nil {
+				orders[len(orders)-1].order = ClusteringOrderDesc
+			}
+		}
+	}
+	return orders
+}
@@ -2,6 +2,7 @@ package schema
 
 import (
 	"github.com/stretchr/testify/assert"
+	"strings"
 	"testing"
 	"github.com/stretchr/testify/require"
 )
@@ -22,7 +23,7 @@ CREATE TABLE sp.mytable (
 );`)
 	require.NoError(t, err)
 	require.NotNil(t, schema)
-	require.Equal(t, 1, len(schema.Tables))
+	require.Equal(t, 1, len(schema.Tables()))
 	table := schema.GetTable("sp", "mytable")
 	require.NotNil(t, table)
 	assert.Equal(t, "table comment line 1\ntable comment line 2", table.Comment)
@@ -50,7 +51,7 @@ ALTER TABLE ab.tbl ADD
 `)
 	require.NoError(t, err)
 	require.NotNil(t, schema)
-	require.Equal(t, 1, len(schema.Tables))
+	require.Equal(t, 1, len(schema.Tables()))
 	table := schema.GetTable("ab", "tbl")
 	require.NotNil(t, table)
 	require.Equal(t, 4, len(table.Columns))
@@ -70,7 +71,7 @@ ALTER TABLE ab.tbl ADD
 	col3 := table.GetColumn("col3")
 	require.NotNil(t, col3)
 	assert.Equal(t, "col3 comment", col3.Comment)
-	assert.Equal(t, "map<string,int>", col3.CqlType)
+	assert.Equal(t, "map<string, int>", col3.CqlType)
 	assert.Equal(t, "col3", col3.Name)
 
 	col4 := table.GetColumn("col4")
@@ -86,7 +87,7 @@ ALTER TABLE ab.tbl RENAME col2 TO col5;
 `)
 	require.NoError(t, err)
 	require.NotNil(t, schema)
-	require.Equal(t, 1, len(schema.Tables))
+	require.Equal(t, 1, len(schema.Tables()))
 	table := schema.GetTable("ab", "tbl")
 	require.NotNil(t, table)
 	require.Equal(t, 2, len(table.Columns))
@@ -134,7 +135,7 @@ ALTER TABLE ab.tbl DROP col1;
 `)
 	require.NoError(t, err)
 	require.NotNil(t, schema)
-	require.Equal(t, 1, len(schema.Tables))
+	require.Equal(t, 1, len(schema.Tables()))
 	table := schema.GetTable("ab", "tbl")
 	require.NotNil(t, table)
 	require.Equal(t, 1, len(table.Columns))
@@ -160,4 +161,346 @@ ALTER TABLE ab.tbl DROP col3;
 `)
 	require.Error(t, err)
 	require.Nil(t, schema)
-}
\ No newline at end of file
+}
+
+func TestCreateTablePrimaryKeyColumn(t *testing.T) {
+	schema, err := ParseString(`CREATE TABLE ab.tbl (col1 text PRIMARY KEY, col2 text);`)
+	require.NoError(t, err)
+	require.NotNil(t, schema)
+	table := schema.GetTable("ab", "tbl")
+	require.NotNil(t, table)
+
+	col1 := table.GetColumn("col1")
+	require.NotNil(t, col1)
+	assert.Equal(t, ColumnKindPartitionKey, col1.Kind)
+	assert.Equal(t, 0, col1.KeyIndex)
+
+	col2 := table.GetColumn("col2")
+	require.NotNil(t, col2)
+	assert.Equal(t, ColumnKindRegular, col2.Kind)
+
+	assert.Equal(t, []*Column{col1}, table.PartitionKey())
+	assert.Empty(t, table.ClusteringKey())
+}
+
+func TestCreateTableCompoundKey(t *testing.T) {
+	schema, err := ParseString(`CREATE TABLE ab.tbl (col1 text, col2 text, col3 text, col4 text,
+	PRIMARY KEY (col2, col4, col1));`)
+	require.NoError(t, err)
+	require.NotNil(t, schema)
+	table := schema.GetTable("ab", "tbl")
+	require.NotNil(t, table)
+
+	col1 := table.GetColumn("col1")
+	col2 := table.GetColumn("col2")
+	col3 := table.GetColumn("col3")
+	col4 := table.GetColumn("col4")
+	assert.Equal(t, []*Column{col2}, table.PartitionKey())
+	assert.Equal(t, []*Column{col4, col1}, table.ClusteringKey())
+	assert.Equal(t, ColumnKindClustering, col1.Kind)
+	assert.Equal(t, 1, col1.KeyIndex)
+	assert.Equal(t, ColumnKindRegular, col3.Kind)
+	assert.Equal(t, ColumnKindClustering, col4.Kind)
+	assert.Equal(t, 0, col4.KeyIndex)
+}
+
+func TestCreateTableCompositeKey(t *testing.T) {
+	schema, err := ParseString(`CREATE TABLE ab.tbl (col1 text, col2 text, col3 text, col4 text,
+	PRIMARY KEY ((col3, col1), col2));
+CREATE TABLE ab.tbl2 (col1 text, col2 text, PRIMARY KEY ((col2, col1)));`)
+	require.NoError(t, err)
+	require.NotNil(t, schema)
+
+	table := schema.GetTable("ab", "tbl")
+	require.NotNil(t, table)
+	assert.Equal(t, []*Column{table.GetColumn("col3"), table.GetColumn("col1")}, table.PartitionKey())
+	assert.Equal(t, []*Column{table.GetColumn("col2")}, table.ClusteringKey())
+	assert.Equal(t, ColumnKindRegular, table.GetColumn("col4").Kind)
+
+	table2 := schema.GetTable("ab", "tbl2")
+	require.NotNil(t, table2)
+	assert.Equal(t, []*Column{table2.GetColumn("col2"), table2.GetColumn("col1")}, table2.PartitionKey())
+	assert.Empty(t, table2.ClusteringKey())
+}
+
+func TestCreateTableUnknownKeyColumn(t *testing.T) {
+	schema, err := ParseString(`CREATE TABLE ab.tbl (col1 text, col2 text, PRIMARY KEY (col1, col3));`)
+	require.Error(t, err)
+	require.Nil(t, schema)
+}
+
+func TestCreateTableDuplicateKeyColumn(t *testing.T) {
+	schema, err := ParseString(`CREATE TABLE ab.tbl (col1 text, col2 text, PRIMARY KEY (col1, col1));`)
+	require.Error(t, err)
+	require.Nil(t, schema)
+}
+
+func TestCreateTableMultiplePrimaryKeys(t *testing.T) {
+	schema, err := ParseString(`CREATE TABLE ab.tbl (col1 text PRIMARY KEY, col2 text, PRIMARY KEY (col2));`)
+	require.Error(t, err)
+	require.Nil(t, schema)
+}
+
+func TestAlterTableDropKeyColumn(t *testing.T) {
+	schema, err := ParseString(`CREATE TABLE ab.tbl (col1 text, col2 text, PRIMARY KEY (col1));
+ALTER TABLE ab.tbl DROP col1;
+`)
+	require.Error(t, err)
+	require.Nil(t, schema)
+}
+
+func TestCreateTableClusteringOrder(t *testing.T) {
+	schema, err := ParseString(`CREATE TABLE ab.tbl (col1 text, col2 timestamp, col3 text, PRIMARY KEY (col1, col2))
+	WITH CLUSTERING ORDER BY (col2 DESC);
+CREATE TABLE ab.tbl2 (col1 text, col2 timestamp, PRIMARY KEY (col1, col2))
+	WITH CLUSTERING ORDER BY (col2);`)
+	require.NoError(t, err)
+	require.NotNil(t, schema)
+
+	table := schema.GetTable("ab", "tbl")
+	require.NotNil(t, table)
+	assert.Equal(t, ClusteringOrderNone, table.GetColumn("col1").ClusteringOrder)
+	assert.Equal(t, ClusteringOrderDesc, table.GetColumn("col2").ClusteringOrder)
+	assert.Equal(t, ClusteringOrderNone, table.GetColumn("col3").ClusteringOrder)
+
+	table2 := schema.GetTable("ab", "tbl2")
+	require.NotNil(t, table2)
+	assert.Equal(t, ClusteringOrderAsc, table2.GetColumn("col2").ClusteringOrder)
+}
+
+func TestCreateTableClusteringOrderDefault(t *testing.T) {
+	schema, err := ParseString(`CREATE TABLE ab.tbl (col1 text, col2 timestamp, PRIMARY KEY (col1, col2));`)
+	require.NoError(t, err)
+	require.NotNil(t, schema)
+	table := schema.GetTable("ab", "tbl")
+	require.NotNil(t, table)
+	assert.Equal(t, ClusteringOrderAsc, table.GetColumn("col2").ClusteringOrder)
+}
+
+func TestCreateTableClusteringOrderNotClusteringColumn(t *testing.T) {
+	schema, err := ParseString(`CREATE TABLE ab.tbl (col1 text, col2 timestamp, col3 text, PRIMARY KEY (col1, col2))
+	WITH CLUSTERING ORDER BY (col1 DESC);`)
+	require.Error(t, err)
+	require.Nil(t, schema)
+}
+
+func TestCreateTableClusteringOrderMultipleColumns(t *testing.T) {
+	schema, err := ParseString(`CREATE TABLE ab.tbl (col1 text, col2 timestamp, col3 text, col4 int,
+	PRIMARY KEY (col1, col2, col3, col4))
+	WITH CLUSTERING ORDER BY (col2 DESC, col3, col4 DESC);`)
+	require.NoError(t, err)
+	require.NotNil(t, schema)
+
+	table := schema.GetTable("ab", "tbl")
+	require.NotNil(t, table)
+	assert.Equal(t, ClusteringOrderDesc, table.GetColumn("col2").ClusteringOrder)
+	assert.Equal(t, ClusteringOrderAsc, table.GetColumn("col3").ClusteringOrder)
+	assert.Equal(t, ClusteringOrderDesc, table.GetColumn("col4").ClusteringOrder)
+}
+
+func TestCreateTableClusteringOrderWrongOrder(t *testing.T) {
+	schema, err := ParseString(`CREATE TABLE ab.tbl (col1 text, col2 timestamp, col3 text, PRIMARY KEY (col1, col2, col3))
+	WITH CLUSTERING ORDER BY (col3 DESC, col2 ASC);`)
+	require.Error(t, err)
+	require.Nil(t, schema)
+}
+
+func TestUseKeyspace(t *testing.T) {
+	schema, err := ParseString(`CREATE TABLE tbl0 (col1 text);
+USE sp;
+CREATE TABLE tbl1 (col1 text);
+CREATE TABLE ab.tbl2 (col1 text);
+ALTER TABLE tbl1 ADD col2 int;
+CREATE TYPE typ (col1 text);
+USE ab;
+ALTER TABLE tbl2 ADD col2 int;
+ALTER TYPE sp.typ ADD col2 int;
+`)
+	require.NoError(t, err)
+	require.NotNil(t, schema)
+
+	assert.NotNil(t, schema.GetTable("", "tbl0"))
+	tbl1 := schema.GetTable("sp", "tbl1")
+	require.NotNil(t, tbl1)
+	assert.Equal(t, "sp", tbl1.Keyspace)
+	assert.NotNil(t, tbl1.GetColumn("col2"))
+	tbl2 := schema.GetTable("ab", "tbl2")
+	require.NotNil(t, tbl2)
+	assert.NotNil(t, tbl2.GetColumn("col2"))
+	typ := schema.GetType("sp", "typ")
+	require.NotNil(t, typ)
+	assert.Equal(t, "sp", typ.Keyspace)
+	assert.NotNil(t, typ.GetField("col2"))
+}
+
+func TestDropTable(t *testing.T) {
+	schema, err := ParseString(`CREATE TABLE ab.tbl (col1 text PRIMARY KEY, col2 text);
+CREATE INDEX idx ON ab.tbl (col2);
+CREATE TABLE ab.tbl2 (col1 text);
+DROP TABLE ab.tbl;
+DROP TABLE IF EXISTS ab.tbl;
+DROP TABLE IF EXISTS cd.tbl;
+`)
+	require.NoError(t, err)
+	require.NotNil(t, schema)
+	require.Equal(t, 1, len(schema.Tables()))
+	assert.Nil(t, schema.GetTable("ab", "tbl"))
+	assert.Nil(t, schema.GetIndex("ab", "idx"))
+	assert.NotNil(t, schema.GetTable("ab", "tbl2"))
+}
+
+func TestDropTableRecreate(t *testing.T) {
+	schema, err := ParseString(`CREATE TABLE ab.tbl (col1 text);
+DROP TABLE ab.tbl;
+CREATE TABLE ab.tbl (col2 text);
+`)
+	require.NoError(t, err)
+	require.NotNil(t, schema)
+	table := schema.GetTable("ab", "tbl")
+	require.NotNil(t, table)
+	require.Equal(t, 1, len(table.Columns))
+	assert.Equal(t, "col2", table.Columns[0].Name)
+}
+
+func TestDropTableNonExisting(t *testing.T) {
+	schema, err := ParseString(`CREATE TABLE ab.tbl (col1 text);
+DROP TABLE ab.tbl2;
+`)
+	require.Error(t, err)
+	require.Nil(t, schema)
+}
+
+func TestDropTableWithMaterializedView(t *testing.T) {
+	schema, err := ParseString(`CREATE TABLE ab.tbl (col1 text PRIMARY KEY, col2 text);
+CREATE MATERIALIZED VIEW ab.v AS SELECT * FROM ab.tbl WHERE col1 IS NOT NULL PRIMARY KEY (col1);
+DROP TABLE IF EXISTS ab.tbl;
+`)
+	require.Error(t, err)
+	require.Nil(t, schema)
+}
+
+func TestCreateTableDuplicate(t *testing.T) {
+	schema, err := ParseString(`CREATE TABLE ab.tbl (col1 text);
+CREATE TABLE ab.tbl (col2 text);
+`)
+	require.Error(t, err)
+	require.Nil(t, schema)
+}
+
+func TestCreateTableIfNotExists(t *testing.T) {
+	schema, err := ParseString(`-- original
+CREATE TABLE ab.tbl (col1 text);
+-- duplicate
+CREATE TABLE IF NOT EXISTS ab.tbl (col2 text PRIMARY KEY);
+CREATE TABLE IF NOT EXISTS ab.tbl2 (col1 text);
+CREATE TABLE IF NOT EXISTS ab.tbl (col1 list<int, int>, col1 text);
+`)
+	require.NoError(t, err)
+	require.NotNil(t, schema)
+	require.Equal(t, 2, len(schema.Tables()))
+	table := schema.GetTable("ab", "tbl")
+	require.NotNil(t, table)
+	assert.Equal(t, "original", table.Comment)
+	require.Equal(t, 1, len(table.Columns))
+	assert.Equal(t, "col1", table.Columns[0].Name)
+	assert.NotNil(t, schema.GetTable("ab", "tbl2"))
+}
+
+func TestCreateTableIfNotExistsSkipsValidation(t *testing.T) {
+	schema, err := ParseString(`CREATE TABLE a.t (k int PRIMARY KEY, v int);
+CREATE TABLE IF NOT EXISTS a.t (k int PRIMARY KEY, y int STATIC);
+`)
+	require.NoError(t, err)
+	table := schema.GetTable("a", "t")
+	require.NotNil(t, table)
+	assert.NotNil(t, table.GetColumn("v"))
+	assert.Nil(t, table.GetColumn("y"))
+}
+
+func TestCreateTableDuplicateView(t *testing.T) {
+	schema, err := ParseString(`CREATE TABLE ab.tbl (col1 text PRIMARY KEY);
+CREATE MATERIALIZED VIEW ab.v AS SELECT * FROM ab.tbl WHERE col1 IS NOT NULL PRIMARY KEY (col1);
+CREATE TABLE ab.v (col1 text);
+`)
+	require.Error(t, err)
+	require.Nil(t, schema)
+}
+
+func TestCreateTableStaticColumn(t *testing.T) {
+	schema, err := ParseString(`CREATE TABLE ab.tbl (
+	col1 text,
+	col2 int,
+	col3 text STATIC,
+	col4 text,
+	PRIMARY KEY (col1, col2)
+);
+ALTER TABLE ab.tbl ADD col5 int STATIC;
+`)
+	require.NoError(t, err)
+	require.NotNil(t, schema)
+	table := schema.GetTable("ab", "tbl")
+	require.NotNil(t, table)
+	assert.False(t, table.GetColumn("col1").Static)
+	assert.False(t, table.GetColumn("col2").Static)
+	assert.True(t, table.GetColumn("col3").Static)
+	assert.Equal(t, "text", table.GetColumn("col3").CqlType)
+	assert.False(t, table.GetColumn("col4").Static)
+	assert.True(t, table.GetColumn("col5").Static)
+}
+
+func TestCreateTableStaticColumnWithoutClustering(t *testing.T) {
+	schema, err := ParseString(`CREATE TABLE ab.tbl (col1 text PRIMARY KEY, col2 text STATIC);`)
+	require.Error(t, err)
+	require.Nil(t, schema)
+
+	schema, err = ParseString(`CREATE TABLE ab.tbl (col1 text PRIMARY KEY, col2 text);
+ALTER TABLE ab.tbl ADD col3 text STATIC;
+`)
+	require.Error(t, err)
+	require.Nil(t, schema)
+}
+
+func TestCreateTableStaticKeyColumn(t *testing.T) {
+	schema, err := ParseString(`CREATE TABLE ab.tbl (col1 text, col2 text STATIC, PRIMARY KEY (col1, col2));`)
+	require.Error(t, err)
+	require.Nil(t, schema)
+}
+
+func TestSchemaApply(t *testing.T) {
+	schema, err := ParseString(`CREATE TABLE ab.tbl (col1 text PRIMARY KEY);`)
+	require.NoError(t, err)
+
+	err = schema.Apply(strings.NewReader(`USE ab;
+ALTER TABLE tbl ADD col2 int;`), WithFileName("0002.cql"))
+	require.NoError(t, err)
+	table := schema.GetTable("ab", "tbl")
+	require.NotNil(t, table)
+	require.Equal(t, 2, len(table.Columns))
+	assert.Equal(t, "int", table.Columns[1].CqlType)
+	assert.Equal(t, []Position{{File: "0002.cql", Line: 2, Column: 1, Offset: 8}}, table.Alterations)
+
+	err = schema.Apply(strings.NewReader(`ALTER TABLE ab.missing ADD col3 int;
+ALTER TABLE ab.tbl ADD col4 int;`))
+	require.Error(t, err)
+	assert.Equal(t, "1:1: Table not found: ab.missing", err.Error())
+	assert.NotNil(t, table.GetColumn("col4"))
+
+	err = schema.Apply(strings.NewReader(`CREATE TABLE ab.dup (col1 int, col1 text, PRIMARY KEY (col1));
+ALTER TABLE ab.tbl ADD col7 int, col1 text;`))
+	require.Error(t, err)
+	assert.Equal(t, 2, len(err.(*ParseError).SemanticErrors))
+	assert.Nil(t, schema.GetTable("ab", "dup"))
+	assert.Nil(t, table.GetColumn("col7"))
+	assert.Equal(t, 2, len(table.Alterations))
+
+	err = schema.Apply(strings.NewReader(`ALTER TABLE ab.tbl ADD col5 int;
+ALTER TABLE ab.tbl ADD col6;`))
+	require.Error(t, err)
+	assert.Nil(t, table.GetColumn("col5"))
+}
+
+func TestSchemaApplyEmpty(t *testing.T) {
+	var schema Schema
+	require.NoError(t, schema.Apply(strings.NewReader(`CREATE TABLE ab.tbl (col1 text PRIMARY KEY);`)))
+	assert.NotNil(t, schema.GetTable("ab", "tbl"))
+}
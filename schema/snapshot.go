@@ -0,0 +1,136 @@
+package schema
+
+// snapshot is the saved state of the schema elements changed by a statement.
+// Restoring it undoes changes of a statement that failed, while pointers to the elements stay valid.
+//
+// Only the list of keyspaces is saved when the snapshot is taken,
+// the statement saves every other element just before it changes the element.
+type snapshot struct {
+	keyspaces []*Keyspace
+	// saved contains the elements that are already saved.
+	saved map[interface{}]bool
+	restores []func()
+}
+
+// snapshot starts saving the state of the schema.
+func (s *Schema) snapshot() *snapshot {
+	return &snapshot{
+		keyspaces: append([]*Keyspace(nil), s.Keyspaces...),
+		saved: make(map[interface{}]bool),
+	}
+}
+
+// restore returns the schema and all saved elements to the saved state.
+func (sn *snapshot) restore(s *Schema) {
+	s.Keyspaces = sn.keyspaces
+	for _, restore := range sn.restores {
+		restore()
+	}
+}
+
+// save returns true if element needs to be saved, that is if it was not saved yet.
+func (sn *snapshot) save(element interface{}) bool {
+	if sn.saved[element] {
+		return false
+	}
+	sn.saved[element] = true
+	return true
+}
+
+// saveKeyspace saves the keyspace and its lists of objects, but not the objects.
+func (sn *snapshot) saveKeyspace(keyspace *Keyspace) {
+	if !sn.save(keyspace) {
+		return
+	}
+	saved := *keyspace
+	saved.ReplicationOptions = cloneMap(keyspace.ReplicationOptions)
+	saved.Alterations = clonePositions(keyspace.Alterations)
+	saved.Tables = append([]*Table(nil), keyspace.Tables...)
+	saved.Types = append([]*Type(nil), keyspace.Types...)
+	saved.Views = append([]*MaterializedView(nil), keyspace.Views...)
+	sn.restores = append(sn.restores, func() { *keyspace = saved })
+}
+
+// saveTable saves the table with its columns and indexes.
+func (sn *snapshot) saveTable(table *Table) {
+	if !sn.save(table) {
+		return
+	}
+	saved := *table
+	saved.Columns = append([]*Column(nil), table.Columns...)
+	saved.Views = append([]string(nil), table.Views...)
+	saved.Indexes = append([]*Index(nil), table.Indexes...)
+	saved.Options = table.Options.clone()
+	saved.Alterations = clonePositions(table.Alterations)
+	sn.restores = append(sn.restores, func() { *table = saved })
+	sn.saveColumns(table.Columns)
+	for _, index := range table.Indexes {
+		index, savedIndex := index, *index
+		sn.restores = append(sn.restores, func() { *index = savedIndex })
+	}
+}
+
+// saveView saves the materialized view with its columns.
+func (sn *snapshot) saveView(view *MaterializedView) {
+	if !sn.save(view) {
+		return
+	}
+	saved := *view
+	saved.Columns = append([]*Column(nil), view.Columns...)
+	saved.Options = view.Options.clone()
+	saved.Alterations = clonePositions(view.Alterations)
+	sn.restores = append(sn.restores, func() { *view = saved })
+	sn.saveColumns(view.Columns)
+}
+
+// saveType saves the user-defined type with its fields.
+// Data types are not saved, they are never modified after parsing.
+func (sn *snapshot) saveType(t *Type) {
+	if !sn.save(t) {
+		return
+	}
+	saved := *t
+	saved.Fields = append([]*Field(nil), t.Fields...)
+	saved.Alterations = clonePositions(t.Alterations)
+	sn.restores = append(sn.restores, func() { *t = saved })
+	for _, field := range t.Fields {
+		field, savedField := field, *field
+		savedField.Alterations = clonePositions(field.Alterations)
+		sn.restores = append(sn.restores, func() { *field = savedField })
+	}
+}
+
+func (sn *snapshot) saveColumns(columns []*Column) {
+	for _, column := range columns {
+		column, saved := column, *column
+		saved.Alterations = clonePositions(column.Alterations)
+		sn.restores = append(sn.restores, func() { *column = saved })
+	}
+}
+
+func (o Options) clone() Options {
+	var ret Options
+	ret.Values = cloneMap(o.Values)
+	if o.Maps != nil {
+		ret.Maps = make(map[string]map[string]string, len(o.Maps))
+		for name, values := range o.Maps {
+			ret.Maps[name] = cloneMap(values)
+		}
+	}
+	return ret
+}
+
+func cloneMap(values map[string]string) map[string]string {
+	if values == nil {
+		return nil
+	}
+	ret := make(map[string]string, len(values))
+	for key, value := range values {
+		ret[key] = value
+	}
+	return ret
+}
+
+func clonePositions(positions []Position) []Position {
+	return append([]Position(nil), positions...)
+}
@@ -0,0 +1,31 @@
+package schema
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestSnapshotRestore(t *testing.T) {
+	schema, err := ParseString(`CREATE TABLE ks.tbl (id int PRIMARY KEY, name text) WITH compaction = {'class': 'LeveledCompactionStrategy'};
+CREATE TYPE ks.typ (a int);`)
+	require.NoError(t, err)
+	table := schema.GetTable("ks", "tbl")
+	column := table.GetColumn("name")
+
+	snapshot := schema.snapshot()
+	snapshot.saveTable(table)
+	snapshot.saveType(schema.GetType("ks", "typ"))
+	require.NoError(t, table.RenameColumn("name", "title"))
+	table.Options.Maps["compaction"]["class"] = "SizeTieredCompactionStrategy"
+	table.Columns = table.Columns[:1]
+	schema.GetType("ks", "typ").Fields = nil
+	schema.keyspace("other")
+	snapshot.restore(schema)
+
+	assert.Equal(t, 1, len(schema.Keyspaces))
+	assert.True(t, table == schema.GetTable("ks", "tbl"))
+	assert.True(t, column == table.GetColumn("name"))
+	assert.Equal(t, "LeveledCompactionStrategy", table.Options.Compaction()["class"])
+	assert.Equal(t, 1, len(schema.GetType("ks", "typ").Fields))
+}
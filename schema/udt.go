@@ -0,0 +1,200 @@
+package schema
+
+import (
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+	"github.com/martin-sucha/cqldoc/parser"
+	"reflect"
+	"strings"
+)
+
+// Type is a user-defined type.
+type Type struct {
+	Comment string
+	// Doc is the parsed Comment, set if the schema was parsed with WithDocComments.
+	Doc *Doc
+	Keyspace string
+	Name string
+	Fields []*Field
+	// Position is the location of the statement that created the type.
+	Position Position
+	// Alterations are locations of ALTER statements that changed the type.
+	Alterations []Position
+}
+
+// Field is a field of a user-defined type.
+type Field struct {
+	Comment string
+	// Doc is the parsed Comment, set if the schema was parsed with WithDocComments.
+	Doc *Doc
+	Name string
+	// CqlType is the data type in the canonical form, see DataType.String.
+	CqlType string
+	// DataType is the structured form of CqlType.
+	DataType *DataType
+	// Position is the location of the field definition.
+	Position Position
+	// Alterations are locations of ALTER statements that changed the field.
+	Alterations []Position
+}
+
+// GetType finds a user-defined type with the keyspace and name.
+// Returns nil if not found.
+func (s *Schema) GetType(keyspace, name string) *Type {
+	k := s.GetKeyspace(keyspace)
+	if k == nil {
+		return nil
+	}
+	return k.GetType(name)
+}
+
+// dropType removes a user-defined type.
+// Returns false if not found.
+func (k *Keyspace) dropType(name string) bool {
+	for idx, t := range k.Types {
+		if t.Name == name {
+			copy(k.Types[idx:], k.Types[idx+1:])
+			k.Types[len(k.Types)-1] = nil
+			k.Types = k.Types[:len(k.Types)-1]
+			return true
+		}
+	}
+	return false
+}
+
+// GetField finds a field by name.
+// Returns nil if not found.
+func (t *Type) GetField(name string) *Field {
+	for _, field := range t.Fields {
+		if field.Name == name {
+			return field
+		}
+	}
+	return nil
+}
+
+// fieldError returns a semantic error related to a field of the type.
+func (t *Type) fieldError(kind ErrorKind, field, message string) *SemanticError {
+	return &SemanticError{Kind: kind, Keyspace: t.Keyspace, Object: t.Name, Column: field, Message: message}
+}
+
+// addField adds a new field to the type.
+func (t *Type) addField(field *Field) {
+	if t.GetField(field.Name) != nil {
+		panic(t.fieldError(ErrorKindDuplicateColumn, field.Name, "Duplicate field found"))
+	}
+	t.Fields = append(t.Fields, field)
+}
+
+// renameField renames a field.
+func (t *Type) renameField(oldName, newName string) {
+	oldField := t.GetField(oldName)
+	if oldField == nil {
+		panic(t.fieldError(ErrorKindUnknownColumn, oldName, "Field does not exist"))
+	}
+	if t.GetField(newName) != nil {
+		panic(t.fieldError(ErrorKindDuplicateColumn, newName, "Duplicate field found"))
+	}
+	oldField.Name = newName
+}
+
+func (l *documentParser) EnterCreateType(ctx *parser.CreateTypeContext) {
+	tokens := l.stream.GetHiddenTokensToLeft(ctx.GetStart().GetTokenIndex(), antlr.TokenHiddenChannel)
+	comment := getComment(tokens)
+
+	keyspaceName, name := l.getTypeName(ctx)
+	duplicate := &SemanticError{Kind: ErrorKindDuplicateType, Keyspace: keyspaceName, Object: name, Message: "Duplicate type found"}
+	checkCreate(l.schema.GetType(keyspaceName, name) != nil, ctx.IfNotExist(), duplicate)
+	l.currentType = &Type{
+		Comment: comment,
+		Keyspace: keyspaceName,
+		Name: name,
+		Position: l.statementPosition,
+	}
+	keyspace := l.schema.keyspace(keyspaceName)
+	l.snapshot.saveKeyspace(keyspace)
+	keyspace.Types = append(keyspace.Types, l.currentType)
+}
+
+func (l *documentParser) ExitCreateType(ctx *parser.CreateTypeContext) {
+	l.currentType = nil
+}
+
+func (l *documentParser) EnterTypeMemberColumnList(ctx *parser.TypeMemberColumnListContext) {
+	l.addFields(ctx)
+}
+
+func (l *documentParser) EnterAlterType(ctx *parser.AlterTypeContext) {
+	keyspace, name := l.getTypeName(ctx)
+	l.currentType = l.schema.GetType(keyspace, name)
+	if l.currentType == nil {
+		panic(&SemanticError{Kind: ErrorKindUnknownType, Keyspace: keyspace, Object: name, Message: "Type not found"})
+	}
+	l.snapshot.saveType(l.currentType)
+	l.currentType.Alterations = append(l.currentType.Alterations, l.statementPosition)
+}
+
+func (l *documentParser) ExitAlterType(ctx *parser.AlterTypeContext) {
+	l.currentType = nil
+}
+
+func (l *documentParser) EnterAlterTypeAdd(ctx *parser.AlterTypeAddContext) {
+	l.addFields(ctx)
+}
+
+func (l *documentParser) EnterAlterTypeRenameItem(ctx *parser.AlterTypeRenameItemContext) {
+	oldName := ctx.GetChildOfType(0, reflect.TypeOf(&parser.ColumnContext{})).GetText()
+	newName := ctx.GetChildOfType(1, reflect.TypeOf(&parser.ColumnContext{})).GetText()
+	l.currentType.renameField(oldName, newName)
+	field := l.currentType.GetField(newName)
+	field.Alterations = append(field.Alterations, l.statementPosition)
+}
+
+func (l *documentParser) EnterAlterTypeAlterType(ctx *parser.AlterTypeAlterTypeContext) {
+	fieldName := ctx.GetChildOfType(0, reflect.TypeOf(&parser.ColumnContext{}))
+	fieldType := ctx.GetChildOfType(0, reflect.TypeOf(&parser.DataTypeContext{}))
+	field := l.currentType.GetField(fieldName.GetText())
+	if field == nil {
+		panic(l.currentType.fieldError(ErrorKindUnknownColumn, fieldName.GetText(), "Field does not exist"))
+	}
+	field.DataType = parseDataType(fieldType.(*parser.DataTypeContext), l.currentType.Keyspace)
+	field.CqlType = field.DataType.String()
+	field.Alterations = append(field.Alterations, l.statementPosition)
+}
+
+func (l *documentParser) EnterDropType(ctx *parser.DropTypeContext) {
+	keyspaceName, name := l.getTypeName(ctx)
+	keyspace := l.schema.GetKeyspace(keyspaceName)
+	if keyspace != nil {
+		l.snapshot.saveKeyspace(keyspace)
+	}
+	if (keyspace == nil || !keyspace.dropType(name)) && ctx.IfExist() == nil {
+		panic(&SemanticError{Kind: ErrorKindUnknownType, Keyspace: keyspaceName, Object: name, Message: "Type not found"})
+	}
+}
+
+// getTypeName returns the keyspace and the name of the user-defined type specified by ctx.
+// Type names can't be quoted, so they are case-insensitive and returned in lowercase.
+func (l *documentParser) getTypeName(ctx typedChildGetter) (keyspace, name string) {
+	keyspace, name = l.getQualifiedName(ctx, reflect.TypeOf(&parser.TypeNameContext{}))
+	return keyspace, strings.ToLower(name)
+}
+
+// addFields adds fields defined by column and dataType pairs of ctx to the current type.
+func (l *documentParser) addFields(ctx antlr.ParserRuleContext) {
+	var field *Field
+	for _, child := range ctx.GetChildren() {
+		switch c := child.(type) {
+		case *parser.ColumnContext:
+			tokens := l.stream.GetHiddenTokensToLeft(c.GetStart().GetTokenIndex(), antlr.TokenHiddenChannel)
+			field = &Field{
+				Comment: getComment(tokens),
+				Name: c.GetText(),
+				Position: l.position(c.GetStart()),
+			}
+		case *parser.DataTypeContext:
+			field.DataType = parseDataType(c, l.currentType.Keyspace)
+			field.CqlType = field.DataType.String()
+			l.currentType.addField(field)
+		}
+	}
+}
@@ -0,0 +1,127 @@
+package schema
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestCreateType(t *testing.T) {
+	schema, err := ParseString(`-- address comment
+CREATE TYPE sp.address (
+    -- street comment
+    street text,
+    city text,
+    zip int
+);`)
+	require.NoError(t, err)
+	require.NotNil(t, schema)
+	require.Equal(t, 1, len(schema.Types()))
+	typ := schema.GetType("sp", "address")
+	require.NotNil(t, typ)
+	assert.Equal(t, "address comment", typ.Comment)
+	require.Equal(t, 3, len(typ.Fields))
+
+	street := typ.GetField("street")
+	require.NotNil(t, street)
+	assert.Equal(t, "street comment", street.Comment)
+	assert.Equal(t, "text", street.CqlType)
+
+	zip := typ.GetField("zip")
+	require.NotNil(t, zip)
+	assert.Equal(t, "", zip.Comment)
+	assert.Equal(t, "int", zip.CqlType)
+}
+
+func TestAlterType(t *testing.T) {
+	schema, err := ParseString(`CREATE TYPE sp.address (street text, city text, zip int);
+ALTER TYPE sp.address ADD
+    -- country comment
+    country text;
+ALTER TYPE sp.address RENAME street TO street1 AND city TO town;
+ALTER TYPE sp.address ALTER zip TYPE varint;
+`)
+	require.NoError(t, err)
+	require.NotNil(t, schema)
+	typ := schema.GetType("sp", "address")
+	require.NotNil(t, typ)
+	require.Equal(t, 4, len(typ.Fields))
+	assert.Equal(t, "street1", typ.Fields[0].Name)
+	assert.Equal(t, "town", typ.Fields[1].Name)
+
+	zip := typ.GetField("zip")
+	require.NotNil(t, zip)
+	assert.Equal(t, "varint", zip.CqlType)
+
+	country := typ.GetField("country")
+	require.NotNil(t, country)
+	assert.Equal(t, "country comment", country.Comment)
+	assert.Equal(t, "text", country.CqlType)
+}
+
+func TestAlterTypeNonExistingType(t *testing.T) {
+	schema, err := ParseString(`CREATE TYPE sp.address (street text);
+ALTER TYPE sp.address2 ADD city text;
+`)
+	require.Error(t, err)
+	require.Nil(t, schema)
+}
+
+func TestAlterTypeNonExistingField(t *testing.T) {
+	schema, err := ParseString(`CREATE TYPE sp.address (street text);
+ALTER TYPE sp.address RENAME city TO town;
+`)
+	require.Error(t, err)
+	require.Nil(t, schema)
+}
+
+func TestAlterTypeDuplicateField(t *testing.T) {
+	schema, err := ParseString(`CREATE TYPE sp.address (street text);
+ALTER TYPE sp.address ADD street text;
+`)
+	require.Error(t, err)
+	require.Nil(t, schema)
+}
+
+func TestDropType(t *testing.T) {
+	schema, err := ParseString(`CREATE TYPE sp.address (street text);
+CREATE TYPE sp.phone (number text);
+DROP TYPE sp.address;
+DROP TYPE IF EXISTS sp.address;
+`)
+	require.NoError(t, err)
+	require.NotNil(t, schema)
+	require.Equal(t, 1, len(schema.Types()))
+	assert.Nil(t, schema.GetType("sp", "address"))
+	assert.NotNil(t, schema.GetType("sp", "phone"))
+}
+
+func TestDropTypeNonExisting(t *testing.T) {
+	schema, err := ParseString(`DROP TYPE sp.address;`)
+	require.Error(t, err)
+	require.Nil(t, schema)
+}
+
+func TestCreateTypeDuplicate(t *testing.T) {
+	schema, err := ParseString(`CREATE TYPE ab.typ (col1 text);
+CREATE TYPE ab.typ (col2 text);
+`)
+	require.Error(t, err)
+	require.Nil(t, schema)
+}
+
+func TestCreateTypeIfNotExists(t *testing.T) {
+	schema, err := ParseString(`-- original
+CREATE TYPE ab.typ (col1 text);
+CREATE TYPE IF NOT EXISTS ab.typ (col2 text);
+CREATE TYPE IF NOT EXISTS ab.typ (col2 list<int, int>);
+`)
+	require.NoError(t, err)
+	require.NotNil(t, schema)
+	require.Equal(t, 1, len(schema.Types()))
+	typ := schema.GetType("ab", "typ")
+	require.NotNil(t, typ)
+	assert.Equal(t, "original", typ.Comment)
+	require.Equal(t, 1, len(typ.Fields))
+	assert.Equal(t, "col1", typ.Fields[0].Name)
+}
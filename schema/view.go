@@ -0,0 +1,258 @@
+package schema
+
+import (
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+	"github.com/martin-sucha/cqldoc/parser"
+	"reflect"
+)
+
+// MaterializedView is a table that Cassandra maintains from rows of a base table.
+// Every write to the base table is also applied to its views.
+type MaterializedView struct {
+	Comment string
+	// Doc is the parsed Comment, set if the schema was parsed with WithDocComments.
+	Doc *Doc
+	Keyspace string
+	Name string
+	// BaseTable is the name of the table the view selects from.
+	// The base table is always in the same keyspace as the view.
+	BaseTable string
+	// IncludeAllColumns is true if the view selects all columns of the base table.
+	// Columns added to the base table later are added to the view as well.
+	IncludeAllColumns bool
+	// Columns are the selected columns with types of the base table columns.
+	Columns []*Column
+	// Where is the text of the WHERE clause that filters rows of the base table.
+	Where string
+	Options Options
+	// Position is the location of the statement that created the view.
+	Position Position
+	// Alterations are locations of ALTER statements that changed the view.
+	Alterations []Position
+	// leadingComment is the comment preceding the CREATE statement.
+	leadingComment string
+}
+
+// GetColumn finds a column by name.
+// Returns nil if not found.
+func (v *MaterializedView) GetColumn(name string) *Column {
+	return findColumn(v.Columns, name)
+}
+
+// PartitionKey returns the partition key columns in key order.
+func (v *MaterializedView) PartitionKey() []*Column {
+	return keyColumns(v.Columns, ColumnKindPartitionKey)
+}
+
+// ClusteringKey returns the clustering columns in key order.
+func (v *MaterializedView) ClusteringKey() []*Column {
+	return keyColumns(v.Columns, ColumnKindClustering)
+}
+
+// GetView finds a materialized view with the keyspace and name.
+// Returns nil if not found.
+func (s *Schema) GetView(keyspace, name string) *MaterializedView {
+	k := s.GetKeyspace(keyspace)
+	if k == nil {
+		return nil
+	}
+	return k.GetView(name)
+}
+
+// Views returns materialized views from all keyspaces.
+func (s *Schema) Views() []*MaterializedView {
+	var ret []*MaterializedView
+	for _, keyspace := range s.Keyspaces {
+		ret = append(ret, keyspace.Views...)
+	}
+	return ret
+}
+
+// GetView finds a materialized view by name.
+// Returns nil if not found.
+func (k *Keyspace) GetView(name string) *MaterializedView {
+	for _, v := range k.Views {
+		if v.Name == name {
+			return v
+		}
+	}
+	return nil
+}
+
+// dropView removes a materialized view and its link from the base table.
+// Returns false if not found.
+func (k *Keyspace) dropView(name string) bool {
+	for idx, v := range k.Views {
+		if v.Name == name {
+			if table := k.GetTable(v.BaseTable); table != nil {
+				table.Views = removeString(table.Views, name)
+			}
+			copy(k.Views[idx:], k.Views[idx+1:])
+			k.Views[len(k.Views)-1] = nil
+			k.Views = k.Views[:len(k.Views)-1]
+			return true
+		}
+	}
+	return false
+}
+
+// tableViews returns materialized views of the table.
+func (s *Schema) tableViews(table *Table) []*MaterializedView {
+	var ret []*MaterializedView
+	for _, name := range table.Views {
+		ret = append(ret, s.GetView(table.Keyspace, name))
+	}
+	return ret
+}
+
+// viewColumn returns a copy of the base table column to be used in a view.
+func viewColumn(column *Column) *Column {
+	ret := *column
+	ret.Alterations = append([]Position(nil), column.Alterations...)
+	ret.Kind = ColumnKindRegular
+	ret.KeyIndex = 0
+	ret.ClusteringOrder = ClusteringOrderNone
+	return &ret
+}
+
+func removeString(values []string, value string) []string {
+	for idx, v := range values {
+		if v == value {
+			return append(values[:idx:idx], values[idx+1:]...)
+		}
+	}
+	return values
+}
+
+func (l *documentParser) EnterCreateMaterializedView(ctx *parser.CreateMaterializedViewContext) {
+	tokens := l.stream.GetHiddenTokensToLeft(ctx.GetStart().GetTokenIndex(), antlr.TokenHiddenChannel)
+	comment := getComment(tokens)
+
+	// The statement contains optional keyspaces of both the view and the base table.
+	keyspaceName, baseKeyspaceName := l.usedKeyspace, l.usedKeyspace
+	var name, baseName, lastKeyspace string
+	for _, child := range ctx.GetChildren() {
+		switch c := child.(type) {
+		case *parser.KeyspaceContext:
+			lastKeyspace = c.GetText()
+		case *parser.MaterializedViewContext:
+			if lastKeyspace != "" {
+				keyspaceName = lastKeyspace
+			}
+			name = c.GetText()
+			lastKeyspace = ""
+		case *parser.TableContext:
+			if lastKeyspace != "" {
+				baseKeyspaceName = lastKeyspace
+			}
+			baseName = c.GetText()
+		}
+	}
+	exists := l.schema.GetView(keyspaceName, name) != nil || l.schema.GetTable(keyspaceName, name) != nil
+	duplicate := &SemanticError{
+		Kind: ErrorKindDuplicateView,
+		Keyspace: keyspaceName,
+		Object: name,
+		Message: "Duplicate materialized view found",
+	}
+	checkCreate(exists, ctx.IfNotExist(), duplicate)
+	if baseKeyspaceName != keyspaceName {
+		panic(&SemanticError{
+			Kind: ErrorKindInvalidView,
+			Keyspace: keyspaceName,
+			Object: name,
+			Message: "Materialized view must be in the keyspace of the base table",
+		})
+	}
+	baseTable := l.schema.GetTable(baseKeyspaceName, baseName)
+	if baseTable == nil {
+		panic(&SemanticError{Kind: ErrorKindUnknownTable, Keyspace: baseKeyspaceName, Object: baseName, Message: "Table not found"})
+	}
+
+	l.currentView = &MaterializedView{
+		Comment: comment,
+		leadingComment: comment,
+		Keyspace: keyspaceName,
+		Name: name,
+		BaseTable: baseName,
+		IncludeAllColumns: ctx.STAR() != nil,
+		Position: l.statementPosition,
+	}
+	if l.currentView.IncludeAllColumns {
+		for _, column := range baseTable.Columns {
+			l.currentView.Columns = append(l.currentView.Columns, viewColumn(column))
+		}
+	} else {
+		for _, child := range ctx.ColumnList().GetChildren() {
+			columnName, ok := child.(*parser.ColumnContext)
+			if !ok {
+				continue
+			}
+			column := baseTable.GetColumn(columnName.GetText())
+			if column == nil {
+				panic(baseTable.columnError(ErrorKindUnknownColumn, columnName.GetText(), "Column does not exist"))
+			}
+			l.currentView.Columns = append(l.currentView.Columns, viewColumn(column))
+		}
+	}
+	l.currentOptions = &l.currentView.Options
+	keyspace := l.schema.keyspace(keyspaceName)
+	l.snapshot.saveKeyspace(keyspace)
+	l.snapshot.saveTable(baseTable)
+	keyspace.Views = append(keyspace.Views, l.currentView)
+	baseTable.Views = append(baseTable.Views, name)
+}
+
+func (l *documentParser) ExitCreateMaterializedView(ctx *parser.CreateMaterializedViewContext) {
+	baseTable := l.schema.GetTable(l.currentView.Keyspace, l.currentView.BaseTable)
+	for _, column := range baseTable.Columns {
+		viewColumn := l.currentView.GetColumn(column.Name)
+		if column.IsKey() && (viewColumn == nil || !viewColumn.IsKey()) {
+			panic(&SemanticError{
+				Kind: ErrorKindInvalidPrimaryKey,
+				Column: column.Name,
+				Message: "Materialized view primary key must include all base table primary key columns",
+			})
+		}
+	}
+	l.currentView.Comment = mergeComment(l.commentPolicy, l.currentView.leadingComment, &l.currentView.Options)
+	l.currentView = nil
+	l.currentOptions = nil
+}
+
+func (l *documentParser) EnterMaterializedViewWhere(ctx *parser.MaterializedViewWhereContext) {
+	conditions := ctx.GetChildOfType(0, reflect.TypeOf(&parser.ColumnNotNullListContext{}))
+	start := conditions.(*parser.ColumnNotNullListContext).GetStart()
+	l.currentView.Where = l.stream.GetTextFromTokens(start, ctx.GetStop())
+}
+
+func (l *documentParser) EnterAlterMaterializedView(ctx *parser.AlterMaterializedViewContext) {
+	keyspace, name := l.getQualifiedName(ctx, reflect.TypeOf(&parser.MaterializedViewContext{}))
+	l.currentView = l.schema.GetView(keyspace, name)
+	if l.currentView == nil {
+		panic(&SemanticError{Kind: ErrorKindUnknownView, Keyspace: keyspace, Object: name, Message: "Materialized view not found"})
+	}
+	l.snapshot.saveView(l.currentView)
+	l.currentView.Alterations = append(l.currentView.Alterations, l.statementPosition)
+	l.currentOptions = &l.currentView.Options
+}
+
+func (l *documentParser) ExitAlterMaterializedView(ctx *parser.AlterMaterializedViewContext) {
+	l.currentView.Comment = mergeComment(l.commentPolicy, l.currentView.leadingComment, &l.currentView.Options)
+	l.currentView = nil
+	l.currentOptions = nil
+}
+
+func (l *documentParser) EnterDropMaterializedView(ctx *parser.DropMaterializedViewContext) {
+	keyspaceName, name := l.getQualifiedName(ctx, reflect.TypeOf(&parser.MaterializedViewContext{}))
+	keyspace := l.schema.GetKeyspace(keyspaceName)
+	if keyspace != nil {
+		l.snapshot.saveKeyspace(keyspace)
+		if view := keyspace.GetView(name); view != nil {
+			l.snapshot.saveTable(keyspace.GetTable(view.BaseTable))
+		}
+	}
+	if (keyspace == nil || !keyspace.dropView(name)) && ctx.IfExist() == nil {
+		panic(&SemanticError{Kind: ErrorKindUnknownView, Keyspace: keyspaceName, Object: name, Message: "Materialized view not found"})
+	}
+}
@@ -0,0 +1,218 @@
+package schema
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+const viewBaseTable = `CREATE TABLE sp.users (
+	id uuid,
+	name text,
+	email text,
+	age int,
+	PRIMARY KEY (id)
+);
+`
+
+func TestCreateMaterializedView(t *testing.T) {
+	schema, err := ParseString(viewBaseTable + `
+-- users by email
+CREATE MATERIALIZED VIEW sp.users_by_email AS
+	SELECT id, email, name FROM sp.users
+	WHERE email IS NOT NULL AND id IS NOT NULL
+	PRIMARY KEY ((email), id)
+	WITH comment = 'by email' AND CLUSTERING ORDER BY (id DESC);
+`)
+	require.NoError(t, err)
+	require.NotNil(t, schema)
+	require.Equal(t, 1, len(schema.Views()))
+	view := schema.GetView("sp", "users_by_email")
+	require.NotNil(t, view)
+	assert.Equal(t, "users by email", view.Comment)
+	assert.Equal(t, "sp", view.Keyspace)
+	assert.Equal(t, "users", view.BaseTable)
+	assert.False(t, view.IncludeAllColumns)
+	assert.Equal(t, "email IS NOT NULL AND id IS NOT NULL", view.Where)
+	assert.Equal(t, map[string]string{"comment": "by email"}, view.Options.Values)
+
+	require.Equal(t, 3, len(view.Columns))
+	assert.Equal(t, "id", view.Columns[0].Name)
+	assert.Equal(t, "uuid", view.Columns[0].CqlType)
+	assert.Equal(t, "email", view.Columns[1].Name)
+	assert.Equal(t, "name", view.Columns[2].Name)
+	assert.Equal(t, []*Column{view.GetColumn("email")}, view.PartitionKey())
+	assert.Equal(t, []*Column{view.GetColumn("id")}, view.ClusteringKey())
+	assert.Equal(t, ClusteringOrderDesc, view.GetColumn("id").ClusteringOrder)
+
+	table := schema.GetTable("sp", "users")
+	require.NotNil(t, table)
+	assert.Equal(t, []string{"users_by_email"}, table.Views)
+	assert.Equal(t, ColumnKindPartitionKey, table.GetColumn("id").Kind)
+	assert.Equal(t, ColumnKindRegular, table.GetColumn("email").Kind)
+}
+
+func TestCreateMaterializedViewOptionsAfterClusteringOrder(t *testing.T) {
+	schema, err := ParseString(viewBaseTable + `CREATE MATERIALIZED VIEW sp.by_age AS
+	SELECT * FROM sp.users WHERE age IS NOT NULL AND name IS NOT NULL AND id IS NOT NULL
+	PRIMARY KEY (age, name, id)
+	WITH CLUSTERING ORDER BY (name DESC, id ASC) AND comment = 'hi';
+CREATE MATERIALIZED VIEW sp.by_name AS
+	SELECT * FROM sp.users WHERE name IS NOT NULL AND id IS NOT NULL
+	PRIMARY KEY (name, id)
+	WITH comment = 'by name' AND CLUSTERING ORDER BY (id DESC) AND gc_grace_seconds = 10;
+`)
+	require.NoError(t, err)
+	require.NotNil(t, schema)
+
+	byAge := schema.GetView("sp", "by_age")
+	require.NotNil(t, byAge)
+	assert.Equal(t, map[string]string{"comment": "hi"}, byAge.Options.Values)
+	assert.Equal(t, ClusteringOrderDesc, byAge.GetColumn("name").ClusteringOrder)
+	assert.Equal(t, ClusteringOrderAsc, byAge.GetColumn("id").ClusteringOrder)
+
+	byName := schema.GetView("sp", "by_name")
+	require.NotNil(t, byName)
+	assert.Equal(t, map[string]string{"comment": "by name", "gc_grace_seconds": "10"}, byName.Options.Values)
+	assert.Equal(t, ClusteringOrderDesc, byName.GetColumn("id").ClusteringOrder)
+}
+
+func TestCreateMaterializedViewAllColumns(t *testing.T) {
+	schema, err := ParseString(viewBaseTable + `
+USE sp;
+CREATE MATERIALIZED VIEW users_by_age AS
+	SELECT * FROM users
+	WHERE age IS NOT NULL AND id IS NOT NULL
+	PRIMARY KEY (age, id);
+ALTER TABLE users ADD phone text;
+`)
+	require.NoError(t, err)
+	require.NotNil(t, schema)
+	view := schema.GetView("sp", "users_by_age")
+	require.NotNil(t, view)
+	assert.True(t, view.IncludeAllColumns)
+	var names []string
+	for _, column := range view.Columns {
+		names = append(names, column.Name)
+	}
+	assert.Equal(t, []string{"id", "name", "email", "age", "phone"}, names)
+	assert.Equal(t, []*Column{view.GetColumn("age")}, view.PartitionKey())
+	assert.Equal(t, []*Column{view.GetColumn("id")}, view.ClusteringKey())
+}
+
+func TestCreateMaterializedViewNonExistingTable(t *testing.T) {
+	schema, err := ParseString(`CREATE MATERIALIZED VIEW sp.v AS
+	SELECT * FROM sp.users WHERE id IS NOT NULL PRIMARY KEY (id);
+`)
+	require.Error(t, err)
+	require.Nil(t, schema)
+}
+
+func TestCreateMaterializedViewNonExistingColumn(t *testing.T) {
+	schema, err := ParseString(viewBaseTable + `CREATE MATERIALIZED VIEW sp.v AS
+	SELECT id, phone FROM sp.users WHERE id IS NOT NULL PRIMARY KEY (id);
+`)
+	require.Error(t, err)
+	require.Nil(t, schema)
+}
+
+func TestCreateMaterializedViewMissingBaseKey(t *testing.T) {
+	schema, err := ParseString(viewBaseTable + `CREATE MATERIALIZED VIEW sp.v AS
+	SELECT id, email FROM sp.users WHERE email IS NOT NULL PRIMARY KEY (email);
+`)
+	require.Error(t, err)
+	require.Nil(t, schema)
+}
+
+func TestCreateMaterializedViewOtherKeyspace(t *testing.T) {
+	schema, err := ParseString(viewBaseTable + `CREATE MATERIALIZED VIEW ab.v AS
+	SELECT * FROM sp.users WHERE id IS NOT NULL PRIMARY KEY (id);
+`)
+	require.Error(t, err)
+	require.Nil(t, schema)
+}
+
+func TestAlterMaterializedView(t *testing.T) {
+	schema, err := ParseString(viewBaseTable + `CREATE MATERIALIZED VIEW sp.v AS
+	SELECT * FROM sp.users WHERE id IS NOT NULL PRIMARY KEY (id)
+	WITH gc_grace_seconds = 10;
+ALTER MATERIALIZED VIEW sp.v WITH compaction = {'class': 'LeveledCompactionStrategy'};
+`)
+	require.NoError(t, err)
+	require.NotNil(t, schema)
+	view := schema.GetView("sp", "v")
+	require.NotNil(t, view)
+	assert.Equal(t, map[string]string{"gc_grace_seconds": "10"}, view.Options.Values)
+	assert.Equal(t, map[string]map[string]string{
+		"compaction": {"class": "LeveledCompactionStrategy"},
+	}, view.Options.Maps)
+}
+
+func TestDropMaterializedView(t *testing.T) {
+	schema, err := ParseString(viewBaseTable + `CREATE MATERIALIZED VIEW sp.v AS
+	SELECT * FROM sp.users WHERE id IS NOT NULL PRIMARY KEY (id);
+DROP MATERIALIZED VIEW sp.v;
+DROP MATERIALIZED VIEW IF EXISTS sp.v;
+ALTER TABLE sp.users DROP age;
+`)
+	require.NoError(t, err)
+	require.NotNil(t, schema)
+	assert.Nil(t, schema.GetView("sp", "v"))
+	assert.Empty(t, schema.GetTable("sp", "users").Views)
+}
+
+func TestDropMaterializedViewNonExisting(t *testing.T) {
+	schema, err := ParseString(`DROP MATERIALIZED VIEW sp.v;`)
+	require.Error(t, err)
+	require.Nil(t, schema)
+}
+
+func TestAlterTableWithMaterializedView(t *testing.T) {
+	schema, err := ParseString(viewBaseTable + `CREATE MATERIALIZED VIEW sp.v AS
+	SELECT id, name FROM sp.users WHERE id IS NOT NULL PRIMARY KEY (id);
+ALTER TABLE sp.users ADD phone text;
+ALTER TABLE sp.users RENAME id TO user_id;
+`)
+	require.NoError(t, err)
+	require.NotNil(t, schema)
+	view := schema.GetView("sp", "v")
+	require.NotNil(t, view)
+	require.Equal(t, 2, len(view.Columns))
+	assert.Equal(t, "user_id", view.Columns[0].Name)
+	assert.Equal(t, "name", view.Columns[1].Name)
+
+	_, err = ParseString(viewBaseTable + `CREATE MATERIALIZED VIEW sp.v AS
+	SELECT * FROM sp.users WHERE id IS NOT NULL PRIMARY KEY (id);
+ALTER TABLE sp.users DROP age;
+`)
+	require.Error(t, err)
+}
+
+func TestCreateMaterializedViewDuplicate(t *testing.T) {
+	schema, err := ParseString(viewBaseTable + `CREATE MATERIALIZED VIEW sp.v AS
+	SELECT * FROM sp.users WHERE id IS NOT NULL PRIMARY KEY (id);
+CREATE MATERIALIZED VIEW sp.v AS
+	SELECT * FROM sp.users WHERE id IS NOT NULL PRIMARY KEY (id);
+`)
+	require.Error(t, err)
+	require.Nil(t, schema)
+}
+
+func TestCreateMaterializedViewIfNotExists(t *testing.T) {
+	schema, err := ParseString(viewBaseTable + `-- original
+CREATE MATERIALIZED VIEW sp.v AS
+	SELECT * FROM sp.users WHERE id IS NOT NULL PRIMARY KEY (id);
+CREATE MATERIALIZED VIEW IF NOT EXISTS sp.v AS
+	SELECT id, name FROM sp.users WHERE id IS NOT NULL PRIMARY KEY (id);
+CREATE MATERIALIZED VIEW IF NOT EXISTS sp.v AS
+	SELECT missing FROM sp.users WHERE missing IS NOT NULL PRIMARY KEY (missing);
+`)
+	require.NoError(t, err)
+	require.NotNil(t, schema)
+	require.Equal(t, 1, len(schema.Views()))
+	view := schema.GetView("sp", "v")
+	require.NotNil(t, view)
+	assert.Equal(t, "original", view.Comment)
+	assert.True(t, view.IncludeAllColumns)
+	assert.Equal(t, []string{"v"}, schema.GetTable("sp", "users").Views)
+}